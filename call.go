@@ -0,0 +1,138 @@
+package lcu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// CallError is returned by Call when the LCU answers with a WAMP CALLERROR
+type CallError struct {
+	Procedure   string
+	ErrorURI    string
+	Description string
+	Details     json.RawMessage
+}
+
+func (e *CallError) Error() string {
+	msg := fmt.Sprintf("call %s failed: %s", e.Procedure, e.ErrorURI)
+	if e.Description != "" {
+		msg += ": " + e.Description
+	}
+	return msg
+}
+
+// callResponse is the outcome of a pending call
+type callResponse struct {
+	result json.RawMessage
+	err    error
+}
+
+// Call invokes a procedure over the already open WebSocket using a WAMP CALL
+// message, avoiding a new HTTPS connection. LCU procedures are named after
+// the HTTP method and path of the endpoint, e.g. "GetLolSummonerV1CurrentSummoner".
+//
+// If ctx has no deadline, Config.CallTimeout bounds the call. Event handlers
+// must not call it when Config.OverflowPolicy is OverflowBlock.
+//
+// Parameters:
+//   - ctx: Context for the call
+//   - procedure: The procedure URI to invoke
+//   - args: Arguments for the procedure, encoded as JSON
+//
+// Returns:
+//   - json.RawMessage: The CALLRESULT payload
+//   - error: A *CallError for CALLERROR responses, or any error sending the call
+func (c *Client) Call(ctx context.Context, procedure string, args ...interface{}) (json.RawMessage, error) {
+	if _, ok := ctx.Deadline(); !ok && c.config.CallTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.CallTimeout)
+		defer cancel()
+	}
+
+	callID := strconv.FormatUint(c.callSeq.Add(1), 10)
+	response := make(chan callResponse, 1)
+
+	c.callMux.Lock()
+	c.calls[callID] = response
+	c.callMux.Unlock()
+
+	defer func() {
+		c.callMux.Lock()
+		delete(c.calls, callID)
+		c.callMux.Unlock()
+	}()
+
+	message := append([]interface{}{2, callID, procedure}, args...)
+	if err := c.sendWebSocketMessage(message); err != nil {
+		return nil, fmt.Errorf("failed to send call message for %s: %w", procedure, err)
+	}
+
+	select {
+	case resp := <-response:
+		if callErr, ok := resp.err.(*CallError); ok {
+			callErr.Procedure = procedure
+		}
+		return resp.result, resp.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("call %s: %w", procedure, ctx.Err())
+	}
+}
+
+// handleCallResponse routes a CALLRESULT or CALLERROR frame to its pending call
+func (c *Client) handleCallResponse(data []byte) {
+	var message []json.RawMessage
+	if err := json.Unmarshal(data, &message); err != nil || len(message) < 2 {
+		return
+	}
+
+	var opcode int
+	var callID string
+	if json.Unmarshal(message[0], &opcode) != nil || json.Unmarshal(message[1], &callID) != nil {
+		return
+	}
+
+	c.callMux.Lock()
+	response, ok := c.calls[callID]
+	delete(c.calls, callID)
+	c.callMux.Unlock()
+
+	if !ok {
+		c.logger.Debug("websocket", "Received response for unknown call %s", callID)
+		return
+	}
+
+	var resp callResponse
+	switch opcode {
+	case 3: // CALLRESULT: [3, callID, result]
+		if len(message) > 2 {
+			resp.result = message[2]
+		}
+	case 4: // CALLERROR: [4, callID, errorURI, errorDesc, errorDetails?]
+		callErr := &CallError{}
+		if len(message) > 2 {
+			json.Unmarshal(message[2], &callErr.ErrorURI)
+		}
+		if len(message) > 3 {
+			json.Unmarshal(message[3], &callErr.Description)
+		}
+		if len(message) > 4 {
+			callErr.Details = message[4]
+		}
+		resp.err = callErr
+	}
+
+	response <- resp
+}
+
+// failPendingCalls completes every pending call with err
+func (c *Client) failPendingCalls(err error) {
+	c.callMux.Lock()
+	defer c.callMux.Unlock()
+
+	for callID, response := range c.calls {
+		response <- callResponse{err: err}
+		delete(c.calls, callID)
+	}
+}
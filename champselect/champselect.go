@@ -0,0 +1,374 @@
+// Package champselect picks and bans champions automatically during
+// champion select. For each pick or ban action of the local player that is
+// in progress, it hovers the first available champion of a per-position
+// priority list and, if configured, locks it in.
+//
+//	engine := champselect.New(client, champselect.Config{
+//		Picks: map[string][]int{
+//			lcu.PositionMiddle: {103, 134}, // Ahri, Syndra
+//			lcu.PositionFill:   {86},       // Garen
+//		},
+//		Bans:      map[string][]int{lcu.PositionFill: {157, 238}},
+//		LockPicks: true,
+//		LockBans:  true,
+//	})
+//	if err := engine.Start(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+//	defer engine.Stop()
+package champselect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	lcu "github.com/its-haze/lcu-gopher"
+)
+
+// sessionEndpoint is the URI the champion select session is published on
+const sessionEndpoint = "/lol-champ-select/v1/session"
+
+// Action types the engine acts on
+const (
+	actionPick = "pick"
+	actionBan  = "ban"
+)
+
+// Config decides which champions the engine picks and bans
+type Config struct {
+	// Champion IDs by priority for each position (lcu.PositionTop ...
+	// lcu.PositionUtility). The lcu.PositionFill list is tried after the
+	// list of the assigned position, and is the only one used in blind pick.
+	Picks map[string][]int
+	Bans  map[string][]int
+
+	LockPicks  bool          // Lock picks in, otherwise they are only hovered
+	LockBans   bool          // Lock bans in, otherwise they are only hovered
+	LockMargin time.Duration // Lock in once this much time is left in the phase, 0 to lock in right away
+	DryRun     bool          // Log the actions instead of performing them
+}
+
+// Engine performs the champion select actions of the local player
+type Engine struct {
+	client *lcu.Client
+	logger lcu.Logger
+	config Config
+
+	mu         sync.Mutex
+	sub        *lcu.Subscription
+	stop       context.CancelFunc
+	session    *lcu.ChampSelectSession // latest session, nil outside of champion select
+	receivedAt time.Time               // when session was received
+	wake       chan struct{}
+}
+
+// progress is what the engine has done in the current champion select. It
+// is owned by the run goroutine.
+type progress struct {
+	hovered map[int]int  // champion hovered by the engine, by action ID
+	locked  map[int]bool // actions locked in by the engine
+	failed  map[int]bool // actions without an available champion, already logged
+}
+
+// New creates an engine for client. It does nothing until Start is called.
+func New(client *lcu.Client, config Config) *Engine {
+	return &Engine{
+		client: client,
+		logger: client.Logger(),
+		config: config,
+	}
+}
+
+// Start subscribes to champion select and acts on the current session, if any.
+//
+// Parameters:
+//   - ctx: Context whose cancellation stops the engine
+//
+// Returns an error if:
+//   - The engine is already started
+//   - Failed to subscribe to champion select events
+func (e *Engine) Start(ctx context.Context) error {
+	e.mu.Lock()
+	if e.stop != nil {
+		e.mu.Unlock()
+		return errors.New("champion select engine already started")
+	}
+	ctx, e.stop = context.WithCancel(ctx)
+	wake := make(chan struct{}, 1)
+	e.wake = wake
+	e.session = nil
+	e.mu.Unlock()
+
+	sub, err := lcu.SubscribeTypedPattern(e.client, sessionEndpoint, func(event *lcu.TypedEvent[lcu.ChampSelectSession]) {
+		if event.EventType == string(lcu.EventTypeDelete) {
+			e.push(nil)
+			return
+		}
+		e.push(&event.Data)
+	}, lcu.EventTypeCreate, lcu.EventTypeUpdate, lcu.EventTypeDelete)
+	if err != nil {
+		e.Stop()
+		return fmt.Errorf("failed to subscribe to champion select: %w", err)
+	}
+
+	e.mu.Lock()
+	e.sub = sub
+	e.mu.Unlock()
+
+	go e.run(ctx, wake)
+
+	session, err := e.client.GetChampSelectSessionContext(ctx)
+	switch {
+	case errors.Is(err, lcu.ErrSummonerNotInChampSelect):
+		// Not in champion select yet
+	case err != nil:
+		e.logger.Error("champselect", "Failed to get champion select session: %v", err)
+	default:
+		e.push(session)
+	}
+	return nil
+}
+
+// Stop unsubscribes from champion select and stops acting
+func (e *Engine) Stop() error {
+	e.mu.Lock()
+	sub := e.sub
+	e.sub = nil
+	if e.stop != nil {
+		e.stop()
+		e.stop = nil
+	}
+	e.mu.Unlock()
+
+	if sub == nil {
+		return nil
+	}
+	return sub.Unsubscribe()
+}
+
+// push replaces the latest session and wakes the run goroutine. Sessions
+// that arrive while an earlier one is processed are coalesced.
+func (e *Engine) push(session *lcu.ChampSelectSession) {
+	e.mu.Lock()
+	e.session = session
+	e.receivedAt = time.Now()
+	wake := e.wake
+	e.mu.Unlock()
+
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// run processes sessions one at a time until ctx is done
+func (e *Engine) run(ctx context.Context, wake <-chan struct{}) {
+	p := &progress{
+		hovered: make(map[int]int),
+		locked:  make(map[int]bool),
+		failed:  make(map[int]bool),
+	}
+
+	var lockTimer *time.Timer
+	var lockC <-chan time.Time
+	defer func() {
+		if lockTimer != nil {
+			lockTimer.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-wake:
+		case <-lockC:
+		}
+
+		e.mu.Lock()
+		session, receivedAt := e.session, e.receivedAt
+		e.mu.Unlock()
+
+		if lockTimer != nil {
+			lockTimer.Stop()
+			lockTimer, lockC = nil, nil
+		}
+
+		if session == nil {
+			clear(p.hovered)
+			clear(p.locked)
+			clear(p.failed)
+			continue
+		}
+
+		if wait := e.process(ctx, p, session, receivedAt); wait > 0 {
+			lockTimer = time.NewTimer(wait)
+			lockC = lockTimer.C
+		}
+	}
+}
+
+// process performs the in-progress actions of the local player and returns
+// how long to wait before a deferred lock in, or 0
+func (e *Engine) process(ctx context.Context, p *progress, session *lcu.ChampSelectSession, receivedAt time.Time) time.Duration {
+	position := localPosition(session)
+	var lockWait time.Duration
+
+	for _, turn := range session.Actions {
+		for _, action := range turn {
+			if action.ActorCellId != session.LocalPlayerCellId || !action.IsInProgress || action.Completed {
+				continue
+			}
+			if action.Type != actionPick && action.Type != actionBan {
+				continue
+			}
+			if wait := e.act(ctx, p, session, action, position, receivedAt); wait > 0 && (lockWait == 0 || wait < lockWait) {
+				lockWait = wait
+			}
+		}
+	}
+	return lockWait
+}
+
+// act hovers and locks in the champion for one action and returns how long
+// to wait before locking it in, or 0
+func (e *Engine) act(ctx context.Context, p *progress, session *lcu.ChampSelectSession, action lcu.ChampSelectAction, position string, receivedAt time.Time) time.Duration {
+	if p.locked[action.Id] {
+		return 0
+	}
+
+	hovered, ours := p.hovered[action.Id]
+	if action.ChampionId != 0 && (!ours || action.ChampionId != hovered) {
+		// The player chose a champion in the client, leave the action to them
+		return 0
+	}
+
+	priorities, lock := e.config.Picks, e.config.LockPicks
+	if action.Type == actionBan {
+		priorities, lock = e.config.Bans, e.config.LockBans
+	}
+
+	championID := choose(candidates(priorities, position), unavailable(session, action.Type))
+	if championID == 0 {
+		if !p.failed[action.Id] {
+			p.failed[action.Id] = true
+			e.logger.Info("champselect", "No available champion to %s for %s", action.Type, positionName(position))
+		}
+		return 0
+	}
+
+	if !ours || hovered != championID {
+		if e.config.DryRun {
+			e.logger.Info("champselect", "Dry run: would hover champion %d to %s (action %d)", championID, action.Type, action.Id)
+		} else if err := e.client.SelectChampionContext(ctx, action.Id, championID); err != nil {
+			e.logger.Error("champselect", "Failed to hover champion %d: %v", championID, err)
+			return 0
+		} else {
+			e.logger.Info("champselect", "Hovered champion %d to %s (action %d)", championID, action.Type, action.Id)
+		}
+		p.hovered[action.Id] = championID
+	}
+
+	if !lock {
+		return 0
+	}
+
+	if wait := lockDelay(session, receivedAt, e.config.LockMargin); wait > 0 {
+		return wait
+	}
+
+	if e.config.DryRun {
+		e.logger.Info("champselect", "Dry run: would lock in champion %d to %s (action %d)", championID, action.Type, action.Id)
+	} else if err := e.client.CompleteChampSelectActionContext(ctx, action.Id); err != nil {
+		e.logger.Error("champselect", "Failed to lock in champion %d: %v", championID, err)
+		return 0
+	} else {
+		e.logger.Info("champselect", "Locked in champion %d to %s (action %d)", championID, action.Type, action.Id)
+	}
+	p.locked[action.Id] = true
+	return 0
+}
+
+// lockDelay returns how long to wait until only margin is left in the
+// current phase, or 0 to lock in now
+func lockDelay(session *lcu.ChampSelectSession, receivedAt time.Time, margin time.Duration) time.Duration {
+	if margin <= 0 || session.Timer.IsInfinite {
+		return 0
+	}
+	timeLeft := time.Duration(session.Timer.AdjustedTimeLeftInPhase)*time.Millisecond - time.Since(receivedAt)
+	if timeLeft <= margin {
+		return 0
+	}
+	return timeLeft - margin
+}
+
+// localPosition returns the position assigned to the local player, empty in blind pick
+func localPosition(session *lcu.ChampSelectSession) string {
+	for _, player := range session.MyTeam {
+		if player.CellId == session.LocalPlayerCellId {
+			return player.AssignedPosition
+		}
+	}
+	return ""
+}
+
+// candidates returns the priority list of position followed by the fill list
+func candidates(priorities map[string][]int, position string) []int {
+	var ids []int
+	if position != "" && position != lcu.PositionFill {
+		ids = append(ids, priorities[position]...)
+	}
+	return append(ids, priorities[lcu.PositionFill]...)
+}
+
+// unavailable returns the champions that cannot be chosen for an action of
+// actionType: banned or picked champions, and for bans also the champions
+// teammates are hovering
+func unavailable(session *lcu.ChampSelectSession, actionType string) []int {
+	ids := slices.Concat(session.Bans.MyTeamBans, session.Bans.TheirTeamBans)
+
+	for _, turn := range session.Actions {
+		for _, action := range turn {
+			if action.ChampionId == 0 || action.ActorCellId == session.LocalPlayerCellId {
+				continue
+			}
+			if action.Completed || (actionType == actionBan && action.IsAllyAction && action.Type == actionPick) {
+				ids = append(ids, action.ChampionId)
+			}
+		}
+	}
+
+	for _, player := range slices.Concat(session.MyTeam, session.TheirTeam) {
+		if player.CellId == session.LocalPlayerCellId {
+			continue
+		}
+		if player.ChampionId != 0 {
+			ids = append(ids, player.ChampionId)
+		}
+		if actionType == actionBan && player.ChampionPickIntent != 0 {
+			ids = append(ids, player.ChampionPickIntent)
+		}
+	}
+	return ids
+}
+
+// choose returns the first candidate that is not unavailable, or 0
+func choose(candidates, unavailable []int) int {
+	for _, id := range candidates {
+		if id != 0 && !slices.Contains(unavailable, id) {
+			return id
+		}
+	}
+	return 0
+}
+
+func positionName(position string) string {
+	if position == "" {
+		return "blind pick"
+	}
+	return position
+}
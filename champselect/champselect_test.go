@@ -0,0 +1,197 @@
+package champselect
+
+import (
+	"testing"
+	"time"
+
+	lcu "github.com/its-haze/lcu-gopher"
+)
+
+// Champion IDs used by the tests
+const (
+	ahri   = 103
+	syndra = 134
+	garen  = 86
+	yasuo  = 157
+	zed    = 238
+)
+
+// localCell is the cell of the local player in sessions built by newSession
+const localCell = 2
+
+// newSession returns a draft session in which the local player is assigned
+// to position and nothing has been picked or banned yet
+func newSession(position string) *lcu.ChampSelectSession {
+	session := &lcu.ChampSelectSession{LocalPlayerCellId: localCell}
+	session.MyTeam = []lcu.ChampSelectPlayer{
+		{CellId: 0, AssignedPosition: lcu.PositionTop},
+		{CellId: 1, AssignedPosition: lcu.PositionJungle},
+		{CellId: localCell, AssignedPosition: position},
+	}
+	session.TheirTeam = []lcu.ChampSelectPlayer{{CellId: 5}, {CellId: 6}}
+	return session
+}
+
+func TestChoose(t *testing.T) {
+	picks := map[string][]int{
+		lcu.PositionMiddle: {ahri, syndra},
+		lcu.PositionFill:   {garen},
+	}
+	bans := map[string][]int{
+		lcu.PositionFill: {yasuo, zed},
+	}
+
+	tests := []struct {
+		name       string
+		position   string
+		actionType string
+		priorities map[string][]int
+		setup      func(session *lcu.ChampSelectSession)
+		want       int
+	}{
+		{
+			name:       "first choice",
+			position:   lcu.PositionMiddle,
+			actionType: actionPick,
+			priorities: picks,
+			want:       ahri,
+		},
+		{
+			name:       "banned",
+			position:   lcu.PositionMiddle,
+			actionType: actionPick,
+			priorities: picks,
+			setup: func(session *lcu.ChampSelectSession) {
+				session.Bans.TheirTeamBans = []int{ahri}
+			},
+			want: syndra,
+		},
+		{
+			name:       "picked by an enemy",
+			position:   lcu.PositionMiddle,
+			actionType: actionPick,
+			priorities: picks,
+			setup: func(session *lcu.ChampSelectSession) {
+				session.Actions = [][]lcu.ChampSelectAction{{
+					{Id: 1, ActorCellId: 5, ChampionId: ahri, Completed: true, Type: actionPick},
+				}}
+			},
+			want: syndra,
+		},
+		{
+			name:       "hovered by the local player",
+			position:   lcu.PositionMiddle,
+			actionType: actionPick,
+			priorities: picks,
+			setup: func(session *lcu.ChampSelectSession) {
+				session.Actions = [][]lcu.ChampSelectAction{{
+					{Id: 1, ActorCellId: localCell, ChampionId: ahri, IsAllyAction: true, IsInProgress: true, Type: actionPick},
+				}}
+				session.MyTeam[localCell].ChampionPickIntent = ahri
+			},
+			want: ahri,
+		},
+		{
+			name:       "ban skips teammate's pick intent",
+			position:   lcu.PositionMiddle,
+			actionType: actionBan,
+			priorities: bans,
+			setup: func(session *lcu.ChampSelectSession) {
+				session.MyTeam[0].ChampionPickIntent = yasuo
+			},
+			want: zed,
+		},
+		{
+			name:       "pick ignores teammate's pick intent",
+			position:   lcu.PositionMiddle,
+			actionType: actionPick,
+			priorities: map[string][]int{lcu.PositionMiddle: {yasuo}},
+			setup: func(session *lcu.ChampSelectSession) {
+				session.MyTeam[0].ChampionPickIntent = yasuo
+			},
+			want: yasuo,
+		},
+		{
+			name:       "fill fallback",
+			position:   lcu.PositionMiddle,
+			actionType: actionPick,
+			priorities: picks,
+			setup: func(session *lcu.ChampSelectSession) {
+				session.Bans.MyTeamBans = []int{ahri}
+				session.Bans.TheirTeamBans = []int{syndra}
+			},
+			want: garen,
+		},
+		{
+			name:       "no list for the position",
+			position:   lcu.PositionBottom,
+			actionType: actionPick,
+			priorities: picks,
+			want:       garen,
+		},
+		{
+			name:       "blind pick",
+			position:   "",
+			actionType: actionPick,
+			priorities: picks,
+			want:       garen,
+		},
+		{
+			name:       "nothing available",
+			position:   lcu.PositionMiddle,
+			actionType: actionPick,
+			priorities: picks,
+			setup: func(session *lcu.ChampSelectSession) {
+				session.Bans.MyTeamBans = []int{ahri, syndra}
+				session.TheirTeam[0].ChampionId = garen
+			},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := newSession(tt.position)
+			if tt.setup != nil {
+				tt.setup(session)
+			}
+
+			got := choose(candidates(tt.priorities, localPosition(session)), unavailable(session, tt.actionType))
+			if got != tt.want {
+				t.Errorf("chose %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeLeft time.Duration
+		infinite bool
+		received time.Duration // how long ago the session was received
+		margin   time.Duration
+		want     time.Duration
+	}{
+		{name: "no margin", timeLeft: 30 * time.Second, margin: 0, want: 0},
+		{name: "infinite timer", timeLeft: 30 * time.Second, infinite: true, margin: 10 * time.Second, want: 0},
+		{name: "wait for the margin", timeLeft: 30 * time.Second, margin: 10 * time.Second, want: 20 * time.Second},
+		{name: "received earlier", timeLeft: 30 * time.Second, received: 5 * time.Second, margin: 10 * time.Second, want: 15 * time.Second},
+		{name: "within the margin", timeLeft: 5 * time.Second, margin: 10 * time.Second, want: 0},
+		{name: "margin passed since received", timeLeft: 30 * time.Second, received: 25 * time.Second, margin: 10 * time.Second, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := newSession(lcu.PositionMiddle)
+			session.Timer.AdjustedTimeLeftInPhase = tt.timeLeft.Milliseconds()
+			session.Timer.IsInfinite = tt.infinite
+
+			got := lockDelay(session, time.Now().Add(-tt.received), tt.margin)
+			// Allow for the time spent running the test
+			if got > tt.want || got < tt.want-time.Second {
+				t.Errorf("lockDelay = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,10 +2,13 @@ package lcu
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -16,7 +19,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
-	"syscall"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -35,15 +38,34 @@ import (
 // The client is designed to be used in a multi-threaded environment,
 // and provides methods for subscribing to events and making requests.
 type Client struct {
-	credentials *Credentials
+	credentials atomic.Pointer[Credentials]
 	httpClient  *http.Client
 	wsConn      *websocket.Conn
 	wsLock      sync.RWMutex
 	eventMux    sync.RWMutex
-	handlers    map[string][]EventHandler
+	handlers    map[string][]handlerEntry
 	done        chan struct{}
 	logger      Logger
 	config      *Config
+
+	// reconnecting is set while the reconnection supervisor is running
+	reconnecting atomic.Bool
+	// monitoring is set once the restart health monitor has been started
+	monitoring atomic.Bool
+	// rotateMu serialises credential rotation after an LCU restart
+	rotateMu sync.Mutex
+
+	// Subscription bookkeeping, guarded by eventMux
+	subscriptions map[uint64]*Subscription
+	topics        map[string]int // WAMP subscription reference counts
+	patterns      *patternIndex
+	dispatcher    *dispatcher
+	subSeq        atomic.Uint64
+
+	// Pending WAMP RPC calls keyed by call ID
+	calls   map[string]chan callResponse
+	callMux sync.Mutex
+	callSeq atomic.Uint64
 }
 
 // Credentials represents the authentication credentials for the League Client API.
@@ -51,6 +73,17 @@ type Credentials struct {
 	Port     int    `json:"port"`
 	Password string `json:"password"`
 	Protocol string `json:"protocol"`
+
+	// Only known when the credentials were read from the lockfile
+	ProcessName string `json:"processName,omitempty"`
+	PID         int    `json:"pid,omitempty"`
+
+	// Riot Client API, only known when the credentials were read from the LeagueClientUx command line
+	RiotClientPort     int    `json:"riotClientPort,omitempty"`
+	RiotClientPassword string `json:"riotClientPassword,omitempty"`
+
+	// League installation directory, only known when it could be inferred from the LeagueClientUx process
+	LeaguePath string `json:"leaguePath,omitempty"`
 }
 
 // EventHandler represents a function that handles LCU events
@@ -66,6 +99,9 @@ type Event struct {
 	EventType string      `json:"eventType"`
 	URI       string      `json:"uri"`
 	Data      interface{} `json:"data"`
+
+	// Raw is the undecoded JSON of Data, nil for events not read from the WebSocket
+	Raw json.RawMessage `json:"-"`
 }
 
 // Logger interface for logging (users can implement their own)
@@ -84,9 +120,37 @@ type Config struct {
 	Debug           bool          // Whether to enable debug logging
 	LogDir          string        // Directory to store endpoint-specific log files
 
+	// Reconnection of the WebSocket after it drops unexpectedly
+	AutoReconnect       bool          // Whether to reconnect and replay subscriptions
+	ReconnectBackoff    time.Duration // Delay before the first reconnection attempt
+	MaxReconnectBackoff time.Duration // Upper bound for the exponential backoff
+
+	// Detection of LCU restarts, which change the port and auth token
+	DetectRestart       bool          // Whether to re-discover credentials and reconnect when the LCU goes away
+	HealthCheckInterval time.Duration // How often to health check the LCU when DetectRestart is set
+
+	CallTimeout time.Duration // Timeout for WAMP RPC calls whose context has no deadline
+
+	Recorder *Recorder // Records all HTTP and WebSocket traffic to a cassette (optional)
+
+	// Event delivery. Events for the same URI are delivered in order by one worker.
+	DispatchWorkers   int            // Number of workers delivering events to handlers
+	DispatchQueueSize int            // Events each worker can queue before OverflowPolicy applies
+	OverflowPolicy    OverflowPolicy // What to do when a worker's queue is full
+
 	// Custom path to League of Legends installation
 	// Example: "C:\\Riot Games\\League of Legends"
 	LeaguePath string
+
+	// Where the LCU is reached. Credentials are discovered unless set explicitly.
+	Host                string               // Host the LCU listens on, 127.0.0.1 if empty
+	Credentials         *Credentials         // Explicit credentials, skipping discovery (optional)
+	CredentialProviders []CredentialProvider // Discovery strategies tried in order, lockfile then process if empty
+	HealthEndpoint      string               // Endpoint requested to check the API is ready
+
+	// Custom path to the Riot Client's Config directory, used by NewRiotClient
+	// Example: "C:\\Users\\me\\AppData\\Local\\Riot Games\\Riot Client\\Config"
+	RiotClientPath string
 }
 
 // DefaultConfig returns a default configuration
@@ -99,6 +163,21 @@ func DefaultConfig() *Config {
 		Debug:           false,
 		LogDir:          "", // Empty by default, will be set if debug is enabled
 		LeaguePath:      "", // Empty by default, will be auto-detected
+		Host:            "127.0.0.1",
+		HealthEndpoint:  lcuHealthEndpoint,
+
+		AutoReconnect:       true,
+		ReconnectBackoff:    1 * time.Second,
+		MaxReconnectBackoff: 30 * time.Second,
+
+		DetectRestart:       false,
+		HealthCheckInterval: 10 * time.Second,
+
+		CallTimeout: 30 * time.Second,
+
+		DispatchWorkers:   4,
+		DispatchQueueSize: 256,
+		OverflowPolicy:    OverflowDropOldest,
 	}
 }
 
@@ -275,6 +354,13 @@ func (l *EndpointLogger) Close() {
 //   - *Client: A new LCU client instance
 //   - error: Any error that occurred during client creation
 func NewClient(config *Config) (*Client, error) {
+	return NewClientContext(context.Background(), config)
+}
+
+// NewClientContext is like NewClient but uses the provided context while
+// looking up credentials. Cancelling ctx stops waiting for the LCU when
+// AwaitConnection is enabled.
+func NewClientContext(ctx context.Context, config *Config) (*Client, error) {
 	if config == nil {
 		config = DefaultConfig()
 	}
@@ -300,13 +386,17 @@ func NewClient(config *Config) (*Client, error) {
 		defaultLogger.debug = config.Debug
 	}
 
-	credentials, err := findCredentials(config)
+	credentials, err := findCredentials(ctx, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find LCU credentials: %w", err)
 	}
 
+	// Remember where League is installed before anything runs in the background
+	if config.LeaguePath == "" && credentials.LeaguePath != "" {
+		config.LeaguePath = credentials.LeaguePath
+	}
+
 	client := &Client{
-		credentials: credentials,
 		httpClient: &http.Client{
 			Timeout: config.Timeout,
 			Transport: &http.Transport{
@@ -315,11 +405,17 @@ func NewClient(config *Config) (*Client, error) {
 				},
 			},
 		},
-		handlers: make(map[string][]EventHandler),
-		done:     make(chan struct{}),
-		logger:   config.Logger,
-		config:   config,
+		handlers:      make(map[string][]handlerEntry),
+		subscriptions: make(map[uint64]*Subscription),
+		topics:        make(map[string]int),
+		patterns:      newPatternIndex(),
+		dispatcher:    newDispatcher(config.DispatchWorkers, config.DispatchQueueSize, config.OverflowPolicy, config.Logger),
+		calls:         make(map[string]chan callResponse),
+		done:          make(chan struct{}),
+		logger:        config.Logger,
+		config:        config,
 	}
+	client.credentials.Store(credentials)
 
 	return client, nil
 }
@@ -329,17 +425,30 @@ func NewClient(config *Config) (*Client, error) {
 // 2. Establishing a WebSocket connection for real-time event handling
 // Returns an error if either connection attempt fails
 func (c *Client) Connect() error {
+	return c.ConnectContext(context.Background())
+}
+
+// ConnectContext is like Connect but uses the provided context for the
+// connection test and the WebSocket handshake. The context only bounds
+// connecting; the established WebSocket stays open until Disconnect.
+func (c *Client) ConnectContext(ctx context.Context) error {
 	// Test HTTP connection first
-	if err := c.testConnection(); err != nil {
+	if err := c.testConnection(ctx); err != nil {
 		return fmt.Errorf("failed to establish HTTP connection: %w", err)
 	}
 
 	// Establish WebSocket connection for events
-	if err := c.connectWebSocket(); err != nil {
+	if err := c.connectWebSocket(ctx); err != nil {
 		return fmt.Errorf("failed to establish WebSocket connection: %w", err)
 	}
 
-	c.logger.Debug("connection", "Successfully connected to LCU on port %d", c.credentials.Port)
+	c.logger.Debug("connection", "Successfully connected to LCU on port %d", c.Credentials().Port)
+
+	// Watch for LCU restarts so the client survives a relaunch
+	if c.config.DetectRestart && c.config.HealthCheckInterval > 0 && c.monitoring.CompareAndSwap(false, true) {
+		go c.monitorHealth()
+	}
+
 	return nil
 }
 
@@ -357,6 +466,9 @@ func (c *Client) Disconnect() error {
 	}
 	c.wsLock.Unlock()
 
+	c.failPendingCalls(fmt.Errorf("client disconnected"))
+	c.dispatcher.close()
+
 	// Close log files if using endpoint logger
 	if endpointLogger, ok := c.logger.(interface {
 		Close()
@@ -367,6 +479,12 @@ func (c *Client) Disconnect() error {
 	return nil
 }
 
+// Logger returns the logger the client was configured with, so packages
+// built on the client can log through it
+func (c *Client) Logger() Logger {
+	return c.logger
+}
+
 // Request sends an HTTP request to the specified endpoint with the given method and body.
 // It handles authentication, logging, and debug mode.
 //
@@ -379,45 +497,76 @@ func (c *Client) Disconnect() error {
 //   - *http.Response: The HTTP response from the request
 //   - error: Any error that occurred during the request
 func (c *Client) Request(method, endpoint string, body io.Reader) (*http.Response, error) {
-	baseURL := fmt.Sprintf("https://127.0.0.1:%d", c.credentials.Port)
-	reqURL, err := url.JoinPath(baseURL, endpoint)
+	return c.RequestContext(context.Background(), method, endpoint, body)
+}
+
+// RequestContext is like Request but uses the provided context.
+// Cancelling ctx aborts the in-flight request.
+func (c *Client) RequestContext(ctx context.Context, method, endpoint string, body io.Reader) (*http.Response, error) {
+	creds := c.Credentials()
+	baseURL := "https://" + lcuAddress(c.config, creds)
+	// JoinPath would escape the query string, so append it separately
+	path, query, _ := strings.Cut(endpoint, "?")
+	reqURL, err := url.JoinPath(baseURL, path)
 	if err != nil {
 		return nil, fmt.Errorf("invalid endpoint: %w", err)
 	}
-
-	req, err := http.NewRequest(method, reqURL, body)
-	if err != nil {
-		return nil, err
+	if query != "" {
+		reqURL += "?" + query
 	}
 
-	// Add authentication header
-	auth := base64.StdEncoding.EncodeToString([]byte("riot:" + c.credentials.Password))
-	req.Header.Set("Authorization", "Basic "+auth)
-	req.Header.Set("Content-Type", "application/json")
+	// Capture the request body for debug logging and recording
+	capture := c.config.Debug || c.config.Recorder != nil
+	var requestBody []byte
+	if capture && body != nil {
+		requestBody, _ = io.ReadAll(body)
+		// Reset body reader for actual request
+		body = bytes.NewReader(requestBody)
+	}
 
 	// Debug logging for request
 	if c.config.Debug {
 		c.logger.Debug(endpoint, "Making %s request to %s", method, reqURL)
 		if body != nil {
-			bodyBytes, _ := io.ReadAll(body)
-			c.logger.Debug(endpoint, "Request body: %s", string(bodyBytes))
-			// Reset body reader for actual request
-			body = bytes.NewReader(bodyBytes)
+			c.logger.Debug(endpoint, "Request body: %s", string(requestBody))
 		}
 	}
 
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add authentication header
+	auth := base64.StdEncoding.EncodeToString([]byte("riot:" + creds.Password))
+	req.Header.Set("Authorization", "Basic "+auth)
+	req.Header.Set("Content-Type", "application/json")
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	// Debug logging for response
-	if c.config.Debug {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		c.logger.Debug(endpoint, "Response status: %s", resp.Status)
-		c.logger.Debug(endpoint, "Response body: %s", string(bodyBytes))
+	if capture {
+		responseBody, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
 		// Reset body reader for actual response
-		resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+		resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+		// Debug logging for response
+		if c.config.Debug {
+			c.logger.Debug(endpoint, "Response status: %s", resp.Status)
+			c.logger.Debug(endpoint, "Response body: %s", string(responseBody))
+		}
+
+		c.record(CassetteEntry{
+			Kind:         CassetteHTTP,
+			Method:       method,
+			Endpoint:     endpoint,
+			RequestBody:  string(requestBody),
+			StatusCode:   resp.StatusCode,
+			ResponseBody: string(responseBody),
+		})
 	}
 
 	return resp, nil
@@ -425,22 +574,52 @@ func (c *Client) Request(method, endpoint string, body io.Reader) (*http.Respons
 
 // Get performs a GET request
 func (c *Client) Get(endpoint string) (*http.Response, error) {
-	return c.Request("GET", endpoint, nil)
+	return c.GetContext(context.Background(), endpoint)
+}
+
+// GetContext performs a GET request using the provided context
+func (c *Client) GetContext(ctx context.Context, endpoint string) (*http.Response, error) {
+	return c.RequestContext(ctx, "GET", endpoint, nil)
 }
 
 // Post performs a POST request
 func (c *Client) Post(endpoint string, body io.Reader) (*http.Response, error) {
-	return c.Request("POST", endpoint, body)
+	return c.PostContext(context.Background(), endpoint, body)
+}
+
+// PostContext performs a POST request using the provided context
+func (c *Client) PostContext(ctx context.Context, endpoint string, body io.Reader) (*http.Response, error) {
+	return c.RequestContext(ctx, "POST", endpoint, body)
 }
 
 // Put performs a PUT request
 func (c *Client) Put(endpoint string, body io.Reader) (*http.Response, error) {
-	return c.Request("PUT", endpoint, body)
+	return c.PutContext(context.Background(), endpoint, body)
+}
+
+// PutContext performs a PUT request using the provided context
+func (c *Client) PutContext(ctx context.Context, endpoint string, body io.Reader) (*http.Response, error) {
+	return c.RequestContext(ctx, "PUT", endpoint, body)
+}
+
+// Patch performs a PATCH request
+func (c *Client) Patch(endpoint string, body io.Reader) (*http.Response, error) {
+	return c.PatchContext(context.Background(), endpoint, body)
+}
+
+// PatchContext performs a PATCH request using the provided context
+func (c *Client) PatchContext(ctx context.Context, endpoint string, body io.Reader) (*http.Response, error) {
+	return c.RequestContext(ctx, "PATCH", endpoint, body)
 }
 
 // Delete performs a DELETE request
 func (c *Client) Delete(endpoint string) (*http.Response, error) {
-	return c.Request("DELETE", endpoint, nil)
+	return c.DeleteContext(context.Background(), endpoint)
+}
+
+// DeleteContext performs a DELETE request using the provided context
+func (c *Client) DeleteContext(ctx context.Context, endpoint string) (*http.Response, error) {
+	return c.RequestContext(ctx, "DELETE", endpoint, nil)
 }
 
 // Valid event types for LCU
@@ -452,7 +631,7 @@ var validEventTypes = map[string]bool{
 
 // Subscribe registers an event handler for a specific endpoint and event types.
 // The handler will be called when events of the specified types (Create, Update, Delete)
-// are received for the given endpoint. The handler is also registered for the general
+// are received for the given endpoint. The client also subscribes to the general
 // event bus (OnJsonApiEvent) to ensure no events are missed.
 //
 // Parameters:
@@ -460,27 +639,67 @@ var validEventTypes = map[string]bool{
 //   - handler: The function that will be called when matching events are received
 //   - eventTypes: One or more event types to filter for (Create, Update, Delete)
 //
+// Returns:
+//   - *Subscription: A handle whose Unsubscribe removes only this handler
+//   - error: An error if no or an invalid event type is given, or the
+//     subscription message could not be sent via WebSocket
+func (c *Client) Subscribe(endpoint string, handler EventHandler, eventTypes ...EventType) (*Subscription, error) {
+	wrappedHandler, err := filterEventTypes(handler, eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Subscribe to both the specific endpoint and the general event bus
+	return c.addSubscription(endpoint, wrappedHandler, endpoint, "OnJsonApiEvent")
+}
+
+// SubscribePattern registers an event handler for every endpoint matching a pattern.
+// Events for all URIs arrive on the general event bus (OnJsonApiEvent), which is
+// the only WAMP topic subscribed to.
+//
+// Patterns starting with "^" are regular expressions matched against the whole URI:
+//
+//	^/lol-chat/v1/conversations/.+/messages$
+//
+// Any other pattern is a glob matched segment by segment, where "*" matches
+// within one path segment and a trailing "**" matches any remaining segments:
+//
+//	/lol-champ-select/v1/summoners/*
+//	/lol-lobby/**
+//
+// Parameters:
+//   - pattern: The glob or regex pattern to match event URIs against
+//   - handler: The function that will be called when matching events are received
+//   - eventTypes: One or more event types to filter for (Create, Update, Delete)
+//
 // Returns an error if:
-//   - No event types are specified
-//   - An invalid event type is provided
+//   - The pattern is not a valid glob or regex
+//   - No event types are specified or an invalid event type is provided
 //   - Failed to send subscription message via WebSocket
-func (c *Client) Subscribe(endpoint string, handler EventHandler, eventTypes ...EventType) error {
+func (c *Client) SubscribePattern(pattern string, handler EventHandler, eventTypes ...EventType) (*Subscription, error) {
+	wrappedHandler, err := filterEventTypes(handler, eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.addPatternSubscription(pattern, wrappedHandler, "OnJsonApiEvent")
+}
+
+// filterEventTypes validates eventTypes and wraps handler so it only sees events of those types
+func filterEventTypes(handler EventHandler, eventTypes []EventType) (EventHandler, error) {
 	// Validate event types if provided
 	if len(eventTypes) > 0 {
 		for _, eventType := range eventTypes {
 			if !validEventTypes[string(eventType)] {
-				return fmt.Errorf("invalid event type: %s. Valid types are: Create, Update, Delete", eventType)
+				return nil, fmt.Errorf("invalid event type: %s. Valid types are: Create, Update, Delete", eventType)
 			}
 		}
 	} else {
-		return fmt.Errorf("at least one event type must be specified. Valid types are: Create, Update, Delete")
+		return nil, fmt.Errorf("at least one event type must be specified. Valid types are: Create, Update, Delete")
 	}
 
-	c.eventMux.Lock()
-	defer c.eventMux.Unlock()
-
 	// Create a wrapper handler that checks event types
-	wrappedHandler := func(event *Event) {
+	return func(event *Event) {
 		// Check if event type matches any of the specified types
 		for _, eventType := range eventTypes {
 			if event.EventType == string(eventType) {
@@ -488,40 +707,36 @@ func (c *Client) Subscribe(endpoint string, handler EventHandler, eventTypes ...
 				return
 			}
 		}
-	}
-
-	// Add handler to both the specific endpoint and the event bus
-	c.handlers[endpoint] = append(c.handlers[endpoint], wrappedHandler)
-	c.handlers["OnJsonApiEvent"] = append(c.handlers["OnJsonApiEvent"], wrappedHandler)
-
-	// Subscribe to both the specific endpoint and the general event bus
-	subscriptions := []string{endpoint, "OnJsonApiEvent"}
-	for _, uri := range subscriptions {
-		message := []interface{}{5, uri}
-		if err := c.sendWebSocketMessage(message); err != nil {
-			return fmt.Errorf("failed to send subscription message for %s: %w", uri, err)
-		}
-	}
-
-	return nil
+	}, nil
 }
 
-// Unsubscribe removes an event handler for a specific endpoint.
-// It sends a WAMP unsubscription message to the WebSocket connection.
+// Unsubscribe removes every event handler for a specific endpoint.
+// A WAMP unsubscription message is sent for each topic no other
+// subscription still needs. Use Subscription.Unsubscribe to remove a
+// single handler.
 //
 // Parameters:
 //   - endpoint: The API endpoint to unsubscribe from (e.g., "/lol-gameflow/v1/session")
 //
 // Returns an error if:
 //   - Failed to send unsubscription message via WebSocket
-//   - Failed to delete the handler from the internal map
 func (c *Client) Unsubscribe(endpoint string) error {
-	c.eventMux.Lock()
-	delete(c.handlers, endpoint)
-	c.eventMux.Unlock()
+	c.eventMux.RLock()
+	var subs []*Subscription
+	for _, sub := range c.subscriptions {
+		if sub.endpoint == endpoint {
+			subs = append(subs, sub)
+		}
+	}
+	c.eventMux.RUnlock()
 
-	// Send unsubscription message via WebSocket (WAMP protocol)
-	return c.sendWebSocketMessage([]interface{}{6, endpoint})
+	var firstErr error
+	for _, sub := range subs {
+		if err := sub.Unsubscribe(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
 }
 
 // SubscribeToAll registers an event handler for all events received from the event bus.
@@ -532,15 +747,14 @@ func (c *Client) Unsubscribe(endpoint string) error {
 //
 // Returns an error if:
 //   - Failed to send subscription message via WebSocket
-//   - Failed to add the handler to the internal map
-func (c *Client) SubscribeToAll(handler EventHandler) error {
+func (c *Client) SubscribeToAll(handler EventHandler) (*Subscription, error) {
 	return c.Subscribe("/", handler, EventTypeCreate, EventTypeUpdate, EventTypeDelete)
 }
 
 // Private methods
 
-func (c *Client) testConnection() error {
-	resp, err := c.Get("/lol-summoner/v1/current-summoner")
+func (c *Client) testConnection(ctx context.Context) error {
+	resp, err := c.GetContext(ctx, healthEndpoint(c.config))
 	if err != nil {
 		return err
 	}
@@ -549,11 +763,12 @@ func (c *Client) testConnection() error {
 	if resp.StatusCode == http.StatusOK {
 		return nil
 	}
-	return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	return NewAPIError(resp)
 }
 
-func (c *Client) connectWebSocket() error {
-	wsURL := fmt.Sprintf("wss://127.0.0.1:%d/", c.credentials.Port)
+func (c *Client) connectWebSocket(ctx context.Context) error {
+	creds := c.Credentials()
+	wsURL := "wss://" + lcuAddress(c.config, creds) + "/"
 
 	if c.config.Debug {
 		c.logger.Debug("websocket", "Connecting to WebSocket at %s", wsURL)
@@ -567,11 +782,11 @@ func (c *Client) connectWebSocket() error {
 	}
 
 	// Add authentication header
-	auth := base64.StdEncoding.EncodeToString([]byte("riot:" + c.credentials.Password))
+	auth := base64.StdEncoding.EncodeToString([]byte("riot:" + creds.Password))
 	headers := http.Header{}
 	headers.Set("Authorization", "Basic "+auth)
 
-	conn, _, err := dialer.Dial(wsURL, headers)
+	conn, _, err := dialer.DialContext(ctx, wsURL, headers)
 	if err != nil {
 		return fmt.Errorf("failed to establish WebSocket connection: %w", err)
 	}
@@ -581,34 +796,55 @@ func (c *Client) connectWebSocket() error {
 	}
 
 	c.wsLock.Lock()
+	// Don't resurrect a client that was disconnected while dialing
+	select {
+	case <-c.done:
+		c.wsLock.Unlock()
+		conn.Close()
+		return fmt.Errorf("client is disconnected")
+	default:
+	}
 	c.wsConn = conn
 	c.wsLock.Unlock()
 
 	// Start listening for messages
-	go c.listenForEvents()
+	go c.listenForEvents(conn)
+
+	c.emitConnectionState(ConnectionStateConnected)
 
 	return nil
 }
 
 func (c *Client) sendWebSocketMessage(message interface{}) error {
-	c.wsLock.RLock()
-	defer c.wsLock.RUnlock()
+	// The WebSocket supports only one concurrent writer, so take the write lock
+	c.wsLock.Lock()
+	defer c.wsLock.Unlock()
 
 	if c.wsConn == nil {
-		return fmt.Errorf("WebSocket connection not established")
+		return errNotConnected
 	}
 
 	if c.config.Debug {
 		c.logger.Debug("websocket", "Sending WebSocket message: %+v", message)
 	}
 
-	return c.wsConn.WriteJSON(message)
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if err := c.wsConn.WriteMessage(websocket.TextMessage, data); err != nil {
+		return err
+	}
+
+	c.record(CassetteEntry{Kind: CassetteSent, Frame: data})
+	return nil
 }
 
-func (c *Client) listenForEvents() {
+func (c *Client) listenForEvents(conn *websocket.Conn) {
 	defer func() {
 		if r := recover(); r != nil {
 			c.logger.Error("websocket", "WebSocket listener panic: %v", r)
+			c.connectionLost(conn)
 		}
 	}()
 
@@ -617,31 +853,44 @@ func (c *Client) listenForEvents() {
 		case <-c.done:
 			return
 		default:
-			var message []interface{}
-			if err := c.wsConn.ReadJSON(&message); err != nil {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				select {
+				case <-c.done:
+					// Closed by Disconnect, nothing to report
+					return
+				default:
+				}
 				c.logger.Error("websocket", "Failed to read WebSocket message: %v", err)
+				c.connectionLost(conn)
 				return
 			}
 
+			c.record(CassetteEntry{Kind: CassetteReceived, Frame: data})
+
+			var message []interface{}
+			if err := json.Unmarshal(data, &message); err != nil {
+				c.logger.Error("websocket", "Failed to decode WebSocket message: %v", err)
+				continue
+			}
+
 			if len(message) > 0 {
 				// First, pass the raw message to OnJsonApiEvent handlers
-				c.eventMux.RLock()
-				handlers := c.handlers["OnJsonApiEvent"]
-				c.eventMux.RUnlock()
-
-				for _, handler := range handlers {
-					go handler(&Event{
-						EventType: "WebSocketMessage",
-						URI:       "OnJsonApiEvent",
-						Data:      message,
-					})
-				}
+				handlers := c.handlersFor("OnJsonApiEvent")
+
+				c.dispatcher.dispatch("OnJsonApiEvent", &Event{
+					EventType: "WebSocketMessage",
+					URI:       "OnJsonApiEvent",
+					Data:      message,
+				}, handlers)
 
 				// Then process specific events if it's an event message
 				if opcode, ok := message[0].(float64); ok {
 					switch opcode {
+					case 3, 4: // CALLRESULT, CALLERROR
+						c.handleCallResponse(data)
 					case 8: // EVENT
-						c.handleEvent(message)
+						c.handleEvent(data)
 					}
 				}
 			}
@@ -649,92 +898,78 @@ func (c *Client) listenForEvents() {
 	}
 }
 
-func (c *Client) handleEvent(message []interface{}) {
-	if len(message) < 3 {
+// handleEvent dispatches a WAMP EVENT frame: [8, eventName, payload].
+// Malformed frames are logged and skipped.
+func (c *Client) handleEvent(data []byte) {
+	var message []json.RawMessage
+	if err := json.Unmarshal(data, &message); err != nil || len(message) < 3 {
+		c.logger.Debug("websocket", "Skipping malformed event frame: %s", data)
 		return
 	}
 
-	eventName, ok := message[1].(string)
-	if !ok {
-		return
+	var eventName string
+	var payload struct {
+		EventType string          `json:"eventType"`
+		URI       string          `json:"uri"`
+		Data      json.RawMessage `json:"data"`
 	}
-
-	eventData, ok := message[2].(map[string]interface{})
-	if !ok {
+	if json.Unmarshal(message[1], &eventName) != nil || json.Unmarshal(message[2], &payload) != nil ||
+		payload.EventType == "" || payload.URI == "" {
+		c.logger.Debug("websocket", "Skipping malformed event frame: %s", data)
 		return
 	}
 
 	event := &Event{
-		EventType: eventData["eventType"].(string),
-		URI:       eventData["uri"].(string),
-		Data:      eventData["data"],
+		EventType: payload.EventType,
+		URI:       payload.URI,
+		Raw:       payload.Data,
+	}
+	if len(payload.Data) > 0 {
+		json.Unmarshal(payload.Data, &event.Data)
 	}
 
 	// Get handlers for the event
-	c.eventMux.RLock()
 	var handlers []EventHandler
 
 	// If this is an OnJsonApiEvent, we want to use the URI from the event data
 	if eventName == "OnJsonApiEvent" {
-		// Get handlers for the specific URI
-		handlers = append(handlers, c.handlers[event.URI]...)
-		// Get handlers for the root path (which catches all events)
-		handlers = append(handlers, c.handlers["/"]...)
+		// Get handlers for the specific URI, for the root path (which catches all events)
+		// and for every pattern matching the URI
+		keys := append([]string{event.URI, "/"}, c.matchPatterns(event.URI)...)
+		handlers = c.handlersFor(keys...)
 	} else {
 		// Otherwise use the event name
-		handlers = append(handlers, c.handlers[eventName]...)
+		handlers = c.handlersFor(eventName)
 	}
-	c.eventMux.RUnlock()
 
-	// Execute all handlers
-	for _, handler := range handlers {
-		go handler(event)
-	}
+	// Queue the event for in-order delivery to all handlers
+	c.dispatcher.dispatch(event.URI, event, handlers)
 }
 
 // findCredentials attempts to find LCU connection credentials
-func findCredentials(config *Config) (*Credentials, error) {
-	// Try lockfile method first
-	if creds, err := findCredentialsFromLockfile(config); err == nil {
-		return creds, nil
+func findCredentials(ctx context.Context, config *Config) (*Credentials, error) {
+	// Explicit credentials skip discovery
+	if config.Credentials != nil {
+		return StaticProvider(config.Credentials).Credentials(ctx, config)
 	}
 
-	// Try process method
-	if creds, err := findCredentialsFromProcess(config); err == nil {
+	// Try each provider in order, lockfile then process by default
+	providers := credentialProviders(config)
+	if creds, err := tryProviders(ctx, config, providers); err == nil {
 		return creds, nil
 	}
 
 	if config.AwaitConnection {
-		return waitForCredentials(config)
+		return awaitCredentials(ctx, config, providers)
 	}
 
 	return nil, fmt.Errorf("no running LCU instance found")
 }
 
 func findCredentialsFromLockfile(config *Config) (*Credentials, error) {
-	var possiblePaths []string
-
-	// If a custom path is provided, use it first
-	if config.LeaguePath != "" {
-		possiblePaths = append(possiblePaths, filepath.Join(config.LeaguePath, "lockfile"))
-	}
-
-	// Add platform-specific default paths
-	switch runtime.GOOS {
-	case "windows":
-		// Try common drive letters
-		for _, drive := range []string{"C", "D", "E", "F", "G"} {
-			possiblePaths = append(possiblePaths, filepath.Join(drive+":", "Riot Games", "League of Legends", "lockfile"))
-		}
-	case "darwin":
-		possiblePaths = append(possiblePaths, "/Applications/League of Legends.app/Contents/LoL/lockfile")
-	case "linux":
-		// Check if we're in WSL2 by looking for the Windows lockfile
-		for _, drive := range []string{"c", "d", "e", "f", "g"} {
-			possiblePaths = append(possiblePaths, filepath.Join("/mnt", drive, "Riot Games", "League of Legends", "lockfile"))
-		}
-	default:
-		return nil, fmt.Errorf("unsupported platform: %s", runtime.GOOS)
+	possiblePaths, err := lockfilePaths(config)
+	if err != nil {
+		return nil, err
 	}
 
 	// Try each possible path
@@ -748,25 +983,16 @@ func findCredentialsFromLockfile(config *Config) (*Credentials, error) {
 			continue // Try next path
 		}
 
-		parts := strings.Split(string(data), ":")
-		if len(parts) != 5 {
-			continue // Invalid format, try next path
-		}
-
-		port, err := strconv.Atoi(parts[2])
+		creds, err := parseLockfile(data)
 		if err != nil {
-			continue // Invalid port, try next path
+			continue // Invalid format, try next path
 		}
 
 		if config.Debug {
 			config.Logger.Debug("lockfile", "Found valid lockfile at: %s", path)
 		}
 
-		return &Credentials{
-			Port:     port,
-			Password: parts[3],
-			Protocol: parts[4],
-		}, nil
+		return creds, nil
 	}
 
 	return nil, fmt.Errorf("no valid lockfile found in any of the possible locations")
@@ -779,11 +1005,12 @@ func findCredentialsFromProcess(config *Config) (*Credentials, error) {
 	switch runtime.GOOS {
 	case "windows":
 		cmd = exec.Command("wmic", "PROCESS", "WHERE", "name='LeagueClientUx.exe'", "GET", "commandline")
-		cmd.SysProcAttr = &syscall.SysProcAttr{
-			HideWindow: true,
-		}
+		hideWindow(cmd)
 	case "darwin":
 		cmd = exec.Command("ps", "-A", "-o", "command", "|", "grep", "LeagueClientUx")
+	case "linux":
+		// Wine/Lutris installs run the Windows client, so scan /proc directly
+		return findCredentialsFromProc(procRoot, config)
 	default:
 		return nil, fmt.Errorf("unsupported platform: %s", runtime.GOOS)
 	}
@@ -809,22 +1036,28 @@ func findCredentialsFromProcess(config *Config) (*Credentials, error) {
 		}
 	}
 
-	// If we found the process path, update the config's LeaguePath
+	creds, err := parseProcessOutput(outputStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we found the process path, report the League installation directory
 	if processPath != "" {
 		// Get the directory containing LeagueClientUx.exe
-		leagueDir := filepath.Dir(processPath)
+		creds.LeaguePath = filepath.Dir(processPath)
 		if config.Debug {
-			config.Logger.Debug("process", "Found League installation at: %s", leagueDir)
+			config.Logger.Debug("process", "Found League installation at: %s", creds.LeaguePath)
 		}
-		config.LeaguePath = leagueDir
 	}
 
-	return parseProcessOutput(outputStr)
+	return creds, nil
 }
 
 func parseProcessOutput(output string) (*Credentials, error) {
 	portRegex := regexp.MustCompile(`--app-port=(\d+)`)
 	passwordRegex := regexp.MustCompile(`--remoting-auth-token=([\w-]+)`)
+	riotPortRegex := regexp.MustCompile(`--riotclient-app-port=(\d+)`)
+	riotPasswordRegex := regexp.MustCompile(`--riotclient-auth-token=([\w-]+)`)
 
 	portMatch := portRegex.FindStringSubmatch(output)
 	passwordMatch := passwordRegex.FindStringSubmatch(output)
@@ -838,15 +1071,47 @@ func parseProcessOutput(output string) (*Credentials, error) {
 		return nil, fmt.Errorf("invalid port: %w", err)
 	}
 
-	return &Credentials{
+	creds := &Credentials{
 		Port:     port,
 		Password: passwordMatch[1],
 		Protocol: "https",
-	}, nil
+	}
+
+	// The Riot Client API is optional, older clients don't pass it
+	riotPortMatch := riotPortRegex.FindStringSubmatch(output)
+	riotPasswordMatch := riotPasswordRegex.FindStringSubmatch(output)
+	if len(riotPortMatch) == 2 && len(riotPasswordMatch) == 2 {
+		if riotPort, err := strconv.Atoi(riotPortMatch[1]); err == nil {
+			creds.RiotClientPort = riotPort
+			creds.RiotClientPassword = riotPasswordMatch[1]
+		}
+	}
+
+	return creds, nil
+}
+
+// lcuAddress returns the host:port the LCU with creds listens on
+func lcuAddress(config *Config, creds *Credentials) string {
+	host := config.Host
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	return net.JoinHostPort(host, strconv.Itoa(creds.Port))
+}
+
+// lcuHealthEndpoint is requested to check the LCU is ready
+const lcuHealthEndpoint = "/lol-summoner/v1/current-summoner"
+
+// healthEndpoint returns the configured health endpoint or the LCU's
+func healthEndpoint(config *Config) string {
+	if config.HealthEndpoint == "" {
+		return lcuHealthEndpoint
+	}
+	return config.HealthEndpoint
 }
 
 // checkLCUHealth verifies if the LCU API is ready to accept connections
-func checkLCUHealth(creds *Credentials, timeout time.Duration, logger Logger) bool {
+func checkLCUHealth(ctx context.Context, config *Config, creds *Credentials, timeout time.Duration, logger Logger) bool {
 	client := &http.Client{
 		Timeout: timeout,
 		Transport: &http.Transport{
@@ -856,10 +1121,10 @@ func checkLCUHealth(creds *Credentials, timeout time.Duration, logger Logger) bo
 		},
 	}
 
-	url := fmt.Sprintf("https://127.0.0.1:%d/lol-summoner/v1/current-summoner", creds.Port)
+	url := "https://" + lcuAddress(config, creds) + healthEndpoint(config)
 	logger.Debug("health", "Attempting health check at %s", url)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		logger.Debug("health", "Failed to create request: %v", err)
 		return false
@@ -880,26 +1145,40 @@ func checkLCUHealth(creds *Credentials, timeout time.Duration, logger Logger) bo
 	return success
 }
 
-func waitForCredentials(config *Config) (*Credentials, error) {
+// waitForCredentials waits for the configured providers to find a healthy LCU
+func waitForCredentials(ctx context.Context, config *Config) (*Credentials, error) {
+	if config.Credentials != nil {
+		return awaitCredentials(ctx, config, []CredentialProvider{StaticProvider(config.Credentials)})
+	}
+	return awaitCredentials(ctx, config, credentialProviders(config))
+}
+
+// awaitCredentials polls providers until one finds an LCU that passes the health check
+func awaitCredentials(ctx context.Context, config *Config, providers []CredentialProvider) (*Credentials, error) {
 	ticker := time.NewTicker(config.PollInterval)
 	defer ticker.Stop()
 
 	logger := config.Logger
 	logger.Debug("connection", "Starting to wait for LCU credentials...")
 
-	for range ticker.C {
-		creds, err := findCredentialsFromProcess(config)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("stopped waiting for LCU credentials: %w", ctx.Err())
+		case <-ticker.C:
+		}
+
+		creds, err := tryProviders(ctx, config, providers)
 		if err != nil {
 			logger.Debug("connection", "Failed to find credentials: %v", err)
 			continue
 		}
 
 		logger.Debug("connection", "Found credentials on port %d, checking health...", creds.Port)
-		if checkLCUHealth(creds, config.Timeout, logger) {
+		if checkLCUHealth(ctx, config, creds, config.Timeout, logger) {
 			logger.Debug("connection", "Health check passed, LCU is ready")
 			return creds, nil
 		}
 		logger.Debug("connection", "Health check failed, continuing to wait...")
 	}
-	return nil, fmt.Errorf("failed to find credentials after waiting")
 }
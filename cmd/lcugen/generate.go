@@ -0,0 +1,498 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// header starts every generated file
+const header = "// Code generated by lcugen. DO NOT EDIT.\n\n"
+
+// methodOrder is the order operations of a path are generated in
+var methodOrder = []string{"get", "post", "put", "patch", "delete", "head", "options"}
+
+// pathParam matches a path template parameter, e.g. {summonerId} or {+path}
+var pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+// reservedParams are names a generated method uses for its own variables,
+// and the names of the packages the generated code may import
+var reservedParams = map[string]bool{
+	"a": true, "ctx": true, "query": true, "body": true, "endpoint": true, "resp": true, "err": true, "result": true,
+	"context": true, "fmt": true, "lcu": true, "strconv": true, "url": true,
+}
+
+type generator struct {
+	doc      *Document
+	pkg      string
+	prefixes []string
+
+	typeNames   map[string]string // schema component name -> Go type name
+	structs     map[string]bool   // Go type names that are structs
+	usedTypes   map[string]bool
+	usedMethods map[string]bool
+
+	modelSrc   bytes.Buffer
+	apiSrc     bytes.Buffer
+	apiImports map[string]bool
+
+	// Inline object schemas waiting to be written as named types
+	nested []nestedType
+
+	models, methods int // Number of generated types and methods
+}
+
+// nestedType is an inline object schema named after its parent and field
+type nestedType struct {
+	name   string
+	schema *Schema
+}
+
+func newGenerator(doc *Document, pkg string, prefixes []string) *generator {
+	return &generator{
+		doc:         doc,
+		pkg:         pkg,
+		prefixes:    prefixes,
+		typeNames:   make(map[string]string),
+		structs:     make(map[string]bool),
+		usedTypes:   map[string]bool{"API": true, "New": true},
+		usedMethods: make(map[string]bool),
+		apiImports:  map[string]bool{"context": true, "github.com/its-haze/lcu-gopher": true},
+	}
+}
+
+// generate returns the formatted source of models.go and api.go
+func (g *generator) generate() (map[string][]byte, error) {
+	components := sortedKeys(g.doc.Components.Schemas)
+	for _, name := range components {
+		g.typeNames[name] = g.uniqueType(exportName(name))
+	}
+	for _, name := range components {
+		g.writeModel(g.typeNames[name], name, g.doc.Components.Schemas[name])
+	}
+
+	for _, path := range sortedKeys(g.doc.Paths) {
+		if !g.included(path) {
+			continue
+		}
+		operations := g.doc.Paths[path]
+		for _, method := range methodOrder {
+			if op, ok := operations[method]; ok {
+				g.writeMethod(path, method, op)
+			}
+		}
+	}
+
+	models, err := g.source(nil, g.modelSrc.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format models.go: %w", err)
+	}
+
+	var api bytes.Buffer
+	api.WriteString("// API calls the LCU endpoints of the schema\n")
+	api.WriteString("type API struct {\n\tclient *lcu.Client\n}\n\n")
+	api.WriteString("// New returns an API sending requests with client\n")
+	api.WriteString("func New(client *lcu.Client) *API {\n\treturn &API{client: client}\n}\n\n")
+	api.Write(g.apiSrc.Bytes())
+
+	apiSrc, err := g.source(g.apiImports, api.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format api.go: %w", err)
+	}
+
+	return map[string][]byte{"models.go": models, "api.go": apiSrc}, nil
+}
+
+// source adds the header, package clause and imports to body and formats it
+func (g *generator) source(imports map[string]bool, body []byte) ([]byte, error) {
+	var src bytes.Buffer
+	src.WriteString(header)
+	fmt.Fprintf(&src, "package %s\n\n", g.pkg)
+	if len(imports) > 0 {
+		// Standard library first, then the rest
+		src.WriteString("import (\n")
+		var thirdParty []string
+		for _, imp := range sortedKeys(imports) {
+			if strings.Contains(imp, ".") {
+				thirdParty = append(thirdParty, imp)
+				continue
+			}
+			fmt.Fprintf(&src, "\t%q\n", imp)
+		}
+		if len(thirdParty) > 0 {
+			src.WriteString("\n")
+			for _, imp := range thirdParty {
+				fmt.Fprintf(&src, "\t%q\n", imp)
+			}
+		}
+		src.WriteString(")\n\n")
+	}
+	src.Write(body)
+	return format.Source(src.Bytes())
+}
+
+// included reports whether methods are generated for path
+func (g *generator) included(path string) bool {
+	if len(g.prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range g.prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeModel writes the type declaration of a schema component
+func (g *generator) writeModel(name, component string, schema *Schema) {
+	g.models++
+	fmt.Fprintf(&g.modelSrc, "// %s is generated from the %s schema component\n", name, component)
+	if schema.Description != "" {
+		fmt.Fprintf(&g.modelSrc, "//\n// %s\n", oneLine(schema.Description))
+	}
+
+	switch {
+	case len(schema.Enum) > 0 && (schema.Type == "string" || schema.Type == ""):
+		fmt.Fprintf(&g.modelSrc, "type %s string\n\n", name)
+		g.modelSrc.WriteString("const (\n")
+		used := make(map[string]bool)
+		for _, value := range schema.Enum {
+			s, ok := value.(string)
+			if !ok {
+				continue
+			}
+			ident := name + exportName(s)
+			for i := 2; used[ident]; i++ {
+				ident = name + exportName(s) + strconv.Itoa(i)
+			}
+			used[ident] = true
+			fmt.Fprintf(&g.modelSrc, "\t%s %s = %q\n", ident, name, s)
+		}
+		g.modelSrc.WriteString(")\n\n")
+	case len(schema.Properties) > 0:
+		g.writeStruct(name, schema)
+	default:
+		fmt.Fprintf(&g.modelSrc, "type %s %s\n\n", name, g.goTypeDeferred(schema, name+"Item"))
+		g.flushNested()
+	}
+}
+
+// writeStruct writes a struct type with a field for every property
+func (g *generator) writeStruct(name string, schema *Schema) {
+	g.structs[name] = true
+
+	// Nested types are written after this one
+	var fields bytes.Buffer
+	used := make(map[string]bool)
+	for _, prop := range sortedKeys(schema.Properties) {
+		field := exportName(prop)
+		for i := 2; used[field]; i++ {
+			field = exportName(prop) + strconv.Itoa(i)
+		}
+		used[field] = true
+
+		propSchema := schema.Properties[prop]
+		if propSchema.Description != "" {
+			fmt.Fprintf(&fields, "\t// %s\n", oneLine(propSchema.Description))
+		}
+		fmt.Fprintf(&fields, "\t%s %s `json:%q`\n", field, g.goTypeDeferred(propSchema, name+field), prop)
+	}
+
+	fmt.Fprintf(&g.modelSrc, "type %s struct {\n", name)
+	g.modelSrc.Write(fields.Bytes())
+	g.modelSrc.WriteString("}\n\n")
+	g.flushNested()
+}
+
+// goTypeDeferred is like goType but defers writing inline object types until flushNested
+func (g *generator) goTypeDeferred(schema *Schema, context string) string {
+	return g.resolve(schema, context, func(name string, s *Schema) {
+		g.nested = append(g.nested, nestedType{name, s})
+	})
+}
+
+// flushNested writes the inline object types collected by goTypeDeferred
+func (g *generator) flushNested() {
+	for len(g.nested) > 0 {
+		n := g.nested[0]
+		g.nested = g.nested[1:]
+		g.models++
+		fmt.Fprintf(&g.modelSrc, "// %s is an inline object of the schema\n", n.name)
+		g.writeStruct(n.name, n.schema)
+	}
+}
+
+// goType returns the Go type of schema, writing inline object types immediately.
+// context names such types.
+func (g *generator) goType(schema *Schema, context string) string {
+	t := g.goTypeDeferred(schema, context)
+	g.flushNested()
+	return t
+}
+
+// resolve maps schema to a Go type, calling define for inline objects with properties
+func (g *generator) resolve(schema *Schema, context string, define func(string, *Schema)) string {
+	if schema == nil {
+		return "interface{}"
+	}
+	if schema.Ref != "" {
+		if name, ok := g.typeNames[refName(schema.Ref)]; ok {
+			return name
+		}
+		return "interface{}"
+	}
+
+	switch schema.Type {
+	case "string":
+		return "string"
+	case "boolean":
+		return "bool"
+	case "integer":
+		switch schema.Format {
+		case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+			return schema.Format
+		}
+		return "int"
+	case "number":
+		if schema.Format == "float" {
+			return "float32"
+		}
+		return "float64"
+	case "array":
+		return "[]" + g.resolve(schema.Items, context+"Item", define)
+	case "object", "":
+		if len(schema.Properties) > 0 {
+			name := g.uniqueType(context)
+			g.structs[name] = true
+			define(name, schema)
+			return name
+		}
+		if additional := schema.additional(); additional != nil {
+			return "map[string]" + g.resolve(additional, context+"Value", define)
+		}
+		if schema.Type == "object" {
+			return "map[string]interface{}"
+		}
+	}
+	return "interface{}"
+}
+
+// writeMethod writes the API method for an operation
+func (g *generator) writeMethod(path, method string, op Operation) {
+	base := exportName(op.OperationID)
+	if base == "" {
+		base = exportName(method + " " + path)
+	}
+	name := base
+	for i := 2; g.usedMethods[name]; i++ {
+		name = base + strconv.Itoa(i)
+	}
+	g.usedMethods[name] = true
+	g.methods++
+
+	// Path parameters, in the order they appear in the path
+	params := make(map[string]Parameter)
+	var query []Parameter
+	for _, p := range op.Parameters {
+		switch p.In {
+		case "path":
+			params[p.Name] = p
+		case "query":
+			query = append(query, p)
+		}
+	}
+
+	var args []string
+	argNames := make(map[string]string)
+	var endpoint strings.Builder
+	endpoint.WriteString("\"")
+	last := 0
+	for _, m := range pathParam.FindAllStringSubmatchIndex(path, -1) {
+		endpoint.WriteString(path[last:m[0]])
+		last = m[1]
+
+		raw := path[m[2]:m[3]]
+		reserved := strings.HasPrefix(raw, "+") // {+path} may contain slashes
+		paramName := strings.TrimPrefix(raw, "+")
+
+		arg, ok := argNames[paramName]
+		argType := "string"
+		if p, ok := params[paramName]; ok && p.Schema != nil {
+			argType = g.goType(p.Schema, name+exportName(paramName))
+		}
+		if !ok {
+			arg = paramIdent(paramName)
+			args = append(args, arg+" "+argType)
+			argNames[paramName] = arg
+		}
+
+		value := arg
+		if argType != "string" {
+			value = "fmt.Sprint(" + arg + ")"
+			g.apiImports["fmt"] = true
+		}
+		if !reserved {
+			value = "url.PathEscape(" + value + ")"
+			g.apiImports["net/url"] = true
+		}
+		endpoint.WriteString("\" + " + value + " + \"")
+	}
+	endpoint.WriteString(path[last:])
+	endpoint.WriteString("\"")
+	endpointExpr := strings.ReplaceAll(endpoint.String(), " + \"\"", "")
+
+	if len(query) > 0 {
+		args = append(args, "query url.Values")
+		g.apiImports["net/url"] = true
+	}
+
+	bodyType := "*struct{}"
+	bodyArg := "nil"
+	if op.RequestBody != nil {
+		if content, ok := op.RequestBody.Content["application/json"]; ok {
+			bodyType = g.goType(content.Schema, name+"Request")
+			bodyArg = "body"
+			args = append(args, "body "+bodyType)
+		}
+	}
+
+	respType := ""
+	for _, code := range sortedKeys(op.Responses) {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+		if content, ok := op.Responses[code].Content["application/json"]; ok && content.Schema != nil {
+			respType = g.goType(content.Schema, name+"Response")
+			break
+		}
+	}
+
+	// Doc comment
+	fmt.Fprintf(&g.apiSrc, "// %s calls %s %s\n", name, strings.ToUpper(method), path)
+	if text := strings.TrimSpace(op.Summary + "\n\n" + op.Description); text != "" {
+		g.apiSrc.WriteString("//\n")
+		for _, line := range strings.Split(text, "\n") {
+			fmt.Fprintf(&g.apiSrc, "// %s\n", strings.TrimRightFunc(line, unicode.IsSpace))
+		}
+	}
+	if len(query) > 0 {
+		var names []string
+		for _, p := range query {
+			names = append(names, p.Name)
+		}
+		fmt.Fprintf(&g.apiSrc, "//\n// Query parameters: %s\n", strings.Join(names, ", "))
+	}
+	if op.Deprecated {
+		g.apiSrc.WriteString("//\n// Deprecated: the endpoint is deprecated in the LCU schema.\n")
+	}
+
+	signature := fmt.Sprintf("func (a *API) %s(%s)", name, strings.Join(append([]string{"ctx context.Context"}, args...), ", "))
+	call := fmt.Sprintf("lcu.DoJSON[%s, %%s](ctx, a.client, %q, endpoint, %s)", bodyType, strings.ToUpper(method), bodyArg)
+
+	switch {
+	case respType == "":
+		fmt.Fprintf(&g.apiSrc, "%s error {\n", signature)
+	case g.structs[respType]:
+		fmt.Fprintf(&g.apiSrc, "%s (*%s, error) {\n", signature, respType)
+	default:
+		fmt.Fprintf(&g.apiSrc, "%s (%s, error) {\n", signature, respType)
+	}
+
+	fmt.Fprintf(&g.apiSrc, "\tendpoint := %s\n", endpointExpr)
+	if len(query) > 0 {
+		g.apiSrc.WriteString("\tif len(query) > 0 {\n\t\tendpoint += \"?\" + query.Encode()\n\t}\n")
+	}
+
+	switch {
+	case respType == "":
+		fmt.Fprintf(&g.apiSrc, "\t_, err := %s\n\treturn err\n", fmt.Sprintf(call, "struct{}"))
+	case g.structs[respType]:
+		fmt.Fprintf(&g.apiSrc, "\treturn %s\n", fmt.Sprintf(call, respType))
+	default:
+		fmt.Fprintf(&g.apiSrc, "\tvar result %s\n", respType)
+		fmt.Fprintf(&g.apiSrc, "\tresp, err := %s\n", fmt.Sprintf(call, respType))
+		g.apiSrc.WriteString("\tif err != nil {\n\t\treturn result, err\n\t}\n\treturn *resp, nil\n")
+	}
+	g.apiSrc.WriteString("}\n\n")
+}
+
+// uniqueType returns name, or name with a number if it is already taken
+func (g *generator) uniqueType(name string) string {
+	if name == "" {
+		name = "Type"
+	}
+	unique := name
+	for i := 2; g.usedTypes[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	g.usedTypes[unique] = true
+	return unique
+}
+
+// oneLine collapses whitespace so text fits a line comment
+func oneLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// idSuffix matches "Id" at the end of a word, e.g. in SummonerId or GameIdList
+var idSuffix = regexp.MustCompile(`Id([A-Z0-9]|$)`)
+
+// exportName turns an arbitrary name into an exported Go identifier
+func exportName(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+
+	name := idSuffix.ReplaceAllString(b.String(), "ID$1")
+	if name != "" && unicode.IsDigit(rune(name[0])) {
+		name = "X" + name
+	}
+	return name
+}
+
+// paramIdent turns a parameter name into an unexported Go identifier
+func paramIdent(s string) string {
+	name := exportName(s)
+	if name == "" {
+		return "param"
+	}
+	runes := []rune(name)
+	// Lower the leading initialism, e.g. ID -> id
+	for i := 0; i < len(runes) && unicode.IsUpper(runes[i]); i++ {
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	name = string(runes)
+	if token.IsKeyword(name) || reservedParams[name] {
+		name += "Param"
+	}
+	return name
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
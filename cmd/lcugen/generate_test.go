@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/format"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// generateFixture runs the generator on testdata/openapi.json
+func generateFixture(t *testing.T) map[string][]byte {
+	t.Helper()
+
+	doc, err := loadDocument(filepath.Join("testdata", "openapi.json"))
+	if err != nil {
+		t.Fatalf("loadDocument: %v", err)
+	}
+	files, err := newGenerator(doc, "lcuapi", nil).generate()
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	return files
+}
+
+func TestGenerateGolden(t *testing.T) {
+	files := generateFixture(t)
+
+	for _, name := range []string{"api.go", "models.go"} {
+		src, ok := files[name]
+		if !ok {
+			t.Fatalf("%s not generated", name)
+		}
+
+		formatted, err := format.Source(src)
+		if err != nil {
+			t.Fatalf("%s does not parse: %v", name, err)
+		}
+		if !bytes.Equal(formatted, src) {
+			t.Errorf("%s is not gofmt formatted", name)
+		}
+
+		golden := filepath.Join("testdata", name+".golden")
+		if *update {
+			if err := os.WriteFile(golden, src, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatalf("%v (run go test -update to create it)", err)
+		}
+		if !bytes.Equal(src, want) {
+			t.Errorf("%s differs from %s; run go test -update if the change is intended\n%s", name, golden, src)
+		}
+	}
+}
+
+func TestGenerateStable(t *testing.T) {
+	first := generateFixture(t)
+	for i := 0; i < 5; i++ {
+		again := generateFixture(t)
+		for name, src := range first {
+			if !bytes.Equal(again[name], src) {
+				t.Fatalf("%s differs between runs", name)
+			}
+		}
+	}
+}
+
+func TestGenerateVet(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs go vet")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+
+	// The package must live inside the module to import lcu. Directories in
+	// testdata are skipped by ./... but can be vetted explicitly.
+	dir, err := os.MkdirTemp("testdata", "vet-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for name, src := range generateFixture(t) {
+		if err := os.WriteFile(filepath.Join(dir, name), src, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command(goTool, "vet", "./"+filepath.ToSlash(dir))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go vet failed: %v\n%s", err, out)
+	}
+}
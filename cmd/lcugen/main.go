@@ -0,0 +1,79 @@
+// Command lcugen generates typed wrappers for LCU endpoints from the
+// client's OpenAPI v3 schema.
+//
+// With swagger enabled (enable_swagger: true in the client's system.yaml),
+// the LCU serves its schema at /swagger/v3/openapi.json. Save it and run:
+//
+//	go run github.com/its-haze/lcu-gopher/cmd/lcugen -schema openapi.json -out ./lcuapi
+//
+// This writes models.go, with a type for every schema component, and
+// api.go, with an API type that has a method for every operation:
+//
+//	api := lcuapi.New(client)
+//	summoner, err := api.GetLolSummonerV1CurrentSummoner(ctx)
+//
+// Use -include to limit the output to some endpoints, e.g.
+// -include /lol-champ-select/,/lol-lobby/. Models are generated for every
+// component either way.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	schemaPath := flag.String("schema", "openapi.json", "path to the saved OpenAPI v3 schema")
+	outDir := flag.String("out", "lcuapi", "directory to write the generated package to")
+	pkg := flag.String("package", "", "name of the generated package (default: base name of -out)")
+	include := flag.String("include", "", "comma-separated path prefixes to generate methods for (default: all)")
+	flag.Parse()
+
+	if err := run(*schemaPath, *outDir, *pkg, *include); err != nil {
+		fmt.Fprintln(os.Stderr, "lcugen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaPath, outDir, pkg, include string) error {
+	doc, err := loadDocument(schemaPath)
+	if err != nil {
+		return err
+	}
+
+	if pkg == "" {
+		abs, err := filepath.Abs(outDir)
+		if err != nil {
+			return err
+		}
+		pkg = strings.ReplaceAll(strings.ToLower(filepath.Base(abs)), "-", "")
+	}
+
+	var prefixes []string
+	for _, prefix := range strings.Split(include, ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	g := newGenerator(doc, pkg, prefixes)
+	files, err := g.generate()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(outDir, name), src, 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	fmt.Printf("lcugen: wrote %d models and %d methods to %s\n", g.models, g.methods, outDir)
+	return nil
+}
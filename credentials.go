@@ -0,0 +1,104 @@
+package lcu
+
+import (
+	"context"
+	"fmt"
+)
+
+// CredentialProvider finds the credentials of a running LCU. Providers are
+// tried in the order of Config.CredentialProviders until one succeeds.
+type CredentialProvider interface {
+	Credentials(ctx context.Context, config *Config) (*Credentials, error)
+}
+
+// CredentialProviderFunc adapts a function to a CredentialProvider
+type CredentialProviderFunc func(ctx context.Context, config *Config) (*Credentials, error)
+
+// Credentials calls f(ctx, config)
+func (f CredentialProviderFunc) Credentials(ctx context.Context, config *Config) (*Credentials, error) {
+	return f(ctx, config)
+}
+
+// LockfileProvider reads the credentials from the lockfile in Config.LeaguePath
+// or a platform-specific default installation directory
+func LockfileProvider() CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context, config *Config) (*Credentials, error) {
+		return findCredentialsFromLockfile(config)
+	})
+}
+
+// ProcessProvider reads the credentials from the command line of the running LeagueClientUx process
+func ProcessProvider() CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context, config *Config) (*Credentials, error) {
+		return findCredentialsFromProcess(config)
+	})
+}
+
+// StaticProvider always returns creds, e.g. for a known port and token or a tunnelled LCU
+func StaticProvider(creds *Credentials) CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context, config *Config) (*Credentials, error) {
+		if creds == nil || creds.Port <= 0 {
+			return nil, fmt.Errorf("invalid static credentials")
+		}
+		c := *creds
+		return &c, nil
+	})
+}
+
+// AwaitProvider polls providers every Config.PollInterval until one of them
+// returns credentials that pass a health check, or ctx is done
+func AwaitProvider(providers ...CredentialProvider) CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context, config *Config) (*Credentials, error) {
+		return awaitCredentials(ctx, config, providers)
+	})
+}
+
+// DefaultCredentialProviders returns the providers used when Config.CredentialProviders is empty
+func DefaultCredentialProviders() []CredentialProvider {
+	return []CredentialProvider{LockfileProvider(), ProcessProvider()}
+}
+
+// credentialProviders returns the configured providers or the defaults
+func credentialProviders(config *Config) []CredentialProvider {
+	if len(config.CredentialProviders) > 0 {
+		return config.CredentialProviders
+	}
+	return DefaultCredentialProviders()
+}
+
+// tryProviders returns the credentials of the first provider that succeeds
+func tryProviders(ctx context.Context, config *Config, providers []CredentialProvider) (*Credentials, error) {
+	var lastErr error
+	for _, provider := range providers {
+		creds, err := provider.Credentials(ctx, config)
+		if err == nil {
+			return creds, nil
+		}
+		lastErr = err
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no credential providers configured")
+	}
+	return nil, lastErr
+}
+
+// NewClientWithCredentials creates a client for an LCU with known credentials,
+// skipping discovery. The host is taken from config (127.0.0.1 by default).
+//
+// Parameters:
+//   - creds: The port and auth token of the LCU
+//   - config: The client configuration, or nil for DefaultConfig
+//
+// Returns an error if creds is nil or has no port.
+func NewClientWithCredentials(creds *Credentials, config *Config) (*Client, error) {
+	if creds == nil || creds.Port <= 0 {
+		return nil, fmt.Errorf("invalid credentials: a port is required")
+	}
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	cfg := *config
+	cfg.Credentials = creds
+	return NewClient(&cfg)
+}
@@ -0,0 +1,204 @@
+package lcu
+
+import (
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy decides what happens when an event arrives for a full dispatch queue
+type OverflowPolicy string
+
+const (
+	// OverflowBlock makes the WebSocket reader wait until the queue has room.
+	// Handlers must not use Client.Call under this policy: its CALLRESULT is
+	// read by the blocked reader, so the call waits until it times out and
+	// no other event is read in the meantime.
+	OverflowBlock OverflowPolicy = "block"
+	// OverflowDropOldest discards the oldest queued event (the default)
+	OverflowDropOldest OverflowPolicy = "drop-oldest"
+	// OverflowCoalesceLatest replaces the newest queued event for the same URI,
+	// so handlers only see the latest state; it drops the oldest event if
+	// none is queued for the URI
+	OverflowCoalesceLatest OverflowPolicy = "coalesce-latest"
+)
+
+// DispatchStats is a snapshot of the event dispatcher
+type DispatchStats struct {
+	Workers    int    // Number of workers
+	QueueDepth []int  // Events waiting in each worker's queue
+	Delivered  uint64 // Events handed to handlers
+	Dropped    uint64 // Events discarded because of a full queue
+	Coalesced  uint64 // Events replaced by a newer event for the same URI
+}
+
+// dispatcher delivers events to handlers on a fixed pool of workers. All
+// events for a URI are routed to the same worker, so handlers see them in
+// the order they were received.
+type dispatcher struct {
+	queues []*dispatchQueue
+	policy OverflowPolicy
+	logger Logger
+
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+	coalesced atomic.Uint64
+}
+
+// dispatchItem is an event and the handlers it is delivered to
+type dispatchItem struct {
+	key      string
+	event    *Event
+	handlers []EventHandler
+}
+
+// dispatchQueue is the bounded queue of a single worker
+type dispatchQueue struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	items  []dispatchItem
+	size   int
+	closed bool
+}
+
+func newDispatcher(workers, queueSize int, policy OverflowPolicy, logger Logger) *dispatcher {
+	if workers <= 0 {
+		workers = 1
+	}
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+	if policy == "" {
+		policy = OverflowDropOldest
+	}
+
+	d := &dispatcher{
+		queues: make([]*dispatchQueue, workers),
+		policy: policy,
+		logger: logger,
+	}
+	for i := range d.queues {
+		q := &dispatchQueue{size: queueSize}
+		q.cond = sync.NewCond(&q.mu)
+		d.queues[i] = q
+		go d.work(q)
+	}
+	return d
+}
+
+// dispatch queues event for delivery to handlers. Events with the same key
+// are delivered in order.
+func (d *dispatcher) dispatch(key string, event *Event, handlers []EventHandler) {
+	if len(handlers) == 0 {
+		return
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	q := d.queues[h.Sum32()%uint32(len(d.queues))]
+
+	item := dispatchItem{key: key, event: event, handlers: handlers}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.items) >= q.size && !q.closed {
+		switch d.policy {
+		case OverflowDropOldest:
+			q.pop()
+			d.dropped.Add(1)
+		case OverflowCoalesceLatest:
+			for i := len(q.items) - 1; i >= 0; i-- {
+				if q.items[i].key == key {
+					q.items[i] = item
+					d.coalesced.Add(1)
+					return
+				}
+			}
+			q.pop()
+			d.dropped.Add(1)
+		default:
+			q.cond.Wait()
+		}
+	}
+
+	if q.closed {
+		return
+	}
+
+	q.items = append(q.items, item)
+	q.cond.Broadcast()
+}
+
+// work delivers the events of q one at a time until the dispatcher is closed
+func (d *dispatcher) work(q *dispatchQueue) {
+	for {
+		q.mu.Lock()
+		for len(q.items) == 0 && !q.closed {
+			q.cond.Wait()
+		}
+		if q.closed {
+			q.mu.Unlock()
+			return
+		}
+		item := q.pop()
+		q.cond.Broadcast()
+		q.mu.Unlock()
+
+		for _, handler := range item.handlers {
+			d.deliver(handler, item.event)
+		}
+		d.delivered.Add(1)
+	}
+}
+
+// pop removes the oldest item of q. The caller must hold q.mu and q must not be empty.
+func (q *dispatchQueue) pop() dispatchItem {
+	item := q.items[0]
+	// Let the event and handlers be collected before the backing array is reallocated
+	q.items[0] = dispatchItem{}
+	q.items = q.items[1:]
+	return item
+}
+
+// deliver runs a single handler, keeping the worker alive if it panics
+func (d *dispatcher) deliver(handler EventHandler, event *Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			d.logger.Error("dispatcher", "Event handler for %s panicked: %v", event.URI, r)
+		}
+	}()
+	handler(event)
+}
+
+// close stops the workers and releases blocked dispatchers. Queued events are discarded.
+func (d *dispatcher) close() {
+	for _, q := range d.queues {
+		q.mu.Lock()
+		q.closed = true
+		q.items = nil
+		q.cond.Broadcast()
+		q.mu.Unlock()
+	}
+}
+
+func (d *dispatcher) stats() DispatchStats {
+	stats := DispatchStats{
+		Workers:    len(d.queues),
+		QueueDepth: make([]int, len(d.queues)),
+		Delivered:  d.delivered.Load(),
+		Dropped:    d.dropped.Load(),
+		Coalesced:  d.coalesced.Load(),
+	}
+	for i, q := range d.queues {
+		q.mu.Lock()
+		stats.QueueDepth[i] = len(q.items)
+		q.mu.Unlock()
+	}
+	return stats
+}
+
+// DispatchStats returns a snapshot of the event dispatcher's queues and counters
+func (c *Client) DispatchStats() DispatchStats {
+	return c.dispatcher.stats()
+}
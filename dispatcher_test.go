@@ -0,0 +1,188 @@
+package lcu
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder collects the events delivered by a dispatcher. Delivery of the
+// first event blocks until release is closed, so the test can fill the queue.
+type recorder struct {
+	mu       sync.Mutex
+	got      []string
+	started  chan struct{}
+	release  chan struct{}
+	finished chan struct{}
+	want     int
+	once     sync.Once
+}
+
+func newRecorder(want int) *recorder {
+	return &recorder{
+		started:  make(chan struct{}),
+		release:  make(chan struct{}),
+		finished: make(chan struct{}),
+		want:     want,
+	}
+}
+
+func (r *recorder) handle(event *Event) {
+	r.once.Do(func() {
+		close(r.started)
+		<-r.release
+	})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.got = append(r.got, fmt.Sprintf("%s#%d", event.URI, event.Data))
+	if len(r.got) == r.want {
+		close(r.finished)
+	}
+}
+
+func (r *recorder) wait(t *testing.T) []string {
+	t.Helper()
+
+	select {
+	case <-r.finished:
+	case <-time.After(5 * time.Second):
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		t.Fatalf("delivered %v, want %d events", r.got, r.want)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.got...)
+}
+
+func TestDispatcherOverflow(t *testing.T) {
+	type event struct {
+		uri string
+		seq int
+	}
+
+	tests := []struct {
+		name          string
+		policy        OverflowPolicy
+		events        []event // dispatched while the first event is being handled
+		wantDelivered []string
+		wantDropped   uint64
+		wantCoalesced uint64
+	}{
+		{
+			name:          "drop oldest",
+			policy:        OverflowDropOldest,
+			events:        []event{{"/a", 2}, {"/a", 3}, {"/b", 1}},
+			wantDelivered: []string{"/a#1", "/b#1"},
+			wantDropped:   2,
+		},
+		{
+			name:          "coalesce latest",
+			policy:        OverflowCoalesceLatest,
+			events:        []event{{"/a", 2}, {"/a", 3}, {"/b", 1}, {"/b", 2}},
+			wantDelivered: []string{"/a#1", "/b#2"},
+			wantDropped:   1,
+			wantCoalesced: 2,
+		},
+		{
+			name:          "block",
+			policy:        OverflowBlock,
+			events:        []event{{"/a", 2}, {"/a", 3}, {"/b", 1}},
+			wantDelivered: []string{"/a#1", "/a#2", "/a#3", "/b#1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDispatcher(1, 1, tt.policy, &defaultLogger{})
+			defer d.close()
+
+			rec := newRecorder(len(tt.wantDelivered))
+			handlers := []EventHandler{rec.handle}
+
+			d.dispatch("/a", &Event{URI: "/a", Data: 1}, handlers)
+			<-rec.started
+
+			dispatched := make(chan struct{})
+			go func() {
+				defer close(dispatched)
+				for _, e := range tt.events {
+					d.dispatch(e.uri, &Event{URI: e.uri, Data: e.seq}, handlers)
+				}
+			}()
+
+			if tt.policy == OverflowBlock {
+				// The second event fills the queue, the third must wait for room
+				select {
+				case <-dispatched:
+					t.Fatal("dispatch did not block on a full queue")
+				case <-time.After(50 * time.Millisecond):
+				}
+			} else {
+				<-dispatched
+			}
+			close(rec.release)
+			<-dispatched
+
+			got := rec.wait(t)
+			if fmt.Sprint(got) != fmt.Sprint(tt.wantDelivered) {
+				t.Errorf("delivered %v, want %v", got, tt.wantDelivered)
+			}
+
+			stats := d.stats()
+			if stats.Dropped != tt.wantDropped {
+				t.Errorf("Dropped = %d, want %d", stats.Dropped, tt.wantDropped)
+			}
+			if stats.Coalesced != tt.wantCoalesced {
+				t.Errorf("Coalesced = %d, want %d", stats.Coalesced, tt.wantCoalesced)
+			}
+		})
+	}
+}
+
+func TestDispatcherKeepsURIOrder(t *testing.T) {
+	const perURI = 200
+	uris := []string{"/a", "/b", "/c", "/d", "/e"}
+
+	for _, policy := range []OverflowPolicy{OverflowBlock, OverflowDropOldest, OverflowCoalesceLatest} {
+		t.Run(string(policy), func(t *testing.T) {
+			d := newDispatcher(2, 1, policy, &defaultLogger{})
+			defer d.close()
+
+			var mu sync.Mutex
+			last := make(map[string]int)
+			handler := func(event *Event) {
+				mu.Lock()
+				defer mu.Unlock()
+				seq := event.Data.(int)
+				if seq <= last[event.URI] {
+					t.Errorf("%s: event %d delivered after %d", event.URI, seq, last[event.URI])
+				}
+				last[event.URI] = seq
+			}
+
+			for seq := 1; seq <= perURI; seq++ {
+				for _, uri := range uris {
+					d.dispatch(uri, &Event{URI: uri, Data: seq}, []EventHandler{handler})
+				}
+			}
+
+			// Every event is either delivered, dropped or coalesced
+			total := uint64(perURI * len(uris))
+			deadline := time.Now().Add(5 * time.Second)
+			stats := d.stats()
+			for stats.Delivered+stats.Dropped+stats.Coalesced != total {
+				if time.Now().After(deadline) {
+					t.Fatalf("stats = %+v, want %d events accounted for", stats, total)
+				}
+				time.Sleep(time.Millisecond)
+				stats = d.stats()
+			}
+			if policy == OverflowBlock && stats.Dropped+stats.Coalesced != 0 {
+				t.Errorf("OverflowBlock lost events: %+v", stats)
+			}
+		})
+	}
+}
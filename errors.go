@@ -1,6 +1,13 @@
 package lcu
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
 
 var (
 	ErrSummonerNotFound         = errors.New("summoner not found")
@@ -9,3 +16,97 @@ var (
 	ErrSummonerNotInChampSelect = errors.New("summoner not in champ select")
 	ErrSummonerNotInQueue       = errors.New("summoner not in queue")
 )
+
+// APIError is returned when the LCU responds with an unexpected status code.
+// It carries the raw response body and, when the LCU sent one, its decoded
+// error payload.
+//
+// A 404 from an endpoint that is only available in a certain state matches
+// the corresponding sentinel error, so callers can branch without string matching:
+//
+//	lobby, err := client.GetLobby()
+//	if errors.Is(err, lcu.ErrSummonerNotInLobby) {
+//		// not in a lobby
+//	}
+type APIError struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+	Body       []byte
+
+	// LCU error payload, empty if the body was not an LCU error
+	ErrorCode  string
+	HTTPStatus int
+	Message    string
+}
+
+// NewAPIError builds an APIError from an unexpected response. It reads the
+// response body but does not close it.
+func NewAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+	}
+
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Endpoint = resp.Request.URL.Path
+	}
+
+	if body, err := io.ReadAll(resp.Body); err == nil {
+		apiErr.Body = body
+
+		// Best effort, the body is not always an LCU error payload
+		var payload struct {
+			ErrorCode  string `json:"errorCode"`
+			HTTPStatus int    `json:"httpStatus"`
+			Message    string `json:"message"`
+		}
+		if json.Unmarshal(body, &payload) == nil {
+			apiErr.ErrorCode = payload.ErrorCode
+			apiErr.HTTPStatus = payload.HTTPStatus
+			apiErr.Message = payload.Message
+		}
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s: status %d", e.Method, e.Endpoint, e.StatusCode)
+	if e.ErrorCode != "" {
+		msg += " (" + e.ErrorCode + ")"
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Unwrap returns the sentinel error matching the endpoint and status, if any
+func (e *APIError) Unwrap() error {
+	if e.StatusCode != http.StatusNotFound {
+		return nil
+	}
+
+	for _, s := range notFoundSentinels {
+		if strings.HasPrefix(e.Endpoint, s.prefix) {
+			return s.err
+		}
+	}
+	return nil
+}
+
+// notFoundSentinels maps endpoint prefixes that return 404 outside of a
+// certain state to a sentinel error. More specific prefixes come first.
+var notFoundSentinels = []struct {
+	prefix string
+	err    error
+}{
+	{"/lol-summoner/v1/summoners", ErrSummonerNotFound},
+	{"/lol-lobby/v2/lobby/matchmaking", ErrSummonerNotInQueue},
+	{"/lol-matchmaking/", ErrSummonerNotInQueue},
+	{"/lol-lobby/", ErrSummonerNotInLobby},
+	{"/lol-champ-select/", ErrSummonerNotInChampSelect},
+	{"/lol-gameflow/v1/session", ErrSummonerNotInGame},
+	{"/liveclientdata/", ErrSummonerNotInGame},
+}
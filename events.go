@@ -0,0 +1,133 @@
+package lcu
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// EventFilter selects the events delivered to an EventStream
+type EventFilter struct {
+	URIs       []string    // Endpoints or SubscribePattern patterns, all events if empty
+	EventTypes []EventType // Event types to deliver, all types if empty
+	BufferSize int         // Capacity of the stream's channel, 64 if zero
+}
+
+// EventStream delivers events on a buffered channel. Events that arrive
+// while the channel is full are dropped and counted.
+type EventStream struct {
+	// C receives the matching events. It is closed when the stream ends.
+	C <-chan *Event
+
+	ch      chan *Event
+	subs    []*Subscription
+	mu      sync.Mutex
+	closed  bool
+	last    *Event
+	dropped atomic.Uint64
+	stop    chan struct{}
+	once    sync.Once
+}
+
+// Events returns a stream of the events matching filter. The stream is
+// closed when ctx is cancelled, the client disconnects or Close is called.
+//
+// Parameters:
+//   - ctx: Context whose cancellation closes the stream
+//   - filter: The URIs and event types to deliver
+//
+// Returns an error if:
+//   - An invalid event type or pattern is given
+//   - Failed to send subscription message via WebSocket
+func (c *Client) Events(ctx context.Context, filter EventFilter) (*EventStream, error) {
+	eventTypes := filter.EventTypes
+	if len(eventTypes) == 0 {
+		eventTypes = []EventType{EventTypeCreate, EventTypeUpdate, EventTypeDelete}
+	}
+	uris := filter.URIs
+	if len(uris) == 0 {
+		uris = []string{"/"}
+	}
+	size := filter.BufferSize
+	if size <= 0 {
+		size = 64
+	}
+
+	ch := make(chan *Event, size)
+	s := &EventStream{
+		C:    ch,
+		ch:   ch,
+		stop: make(chan struct{}),
+	}
+
+	for _, uri := range uris {
+		var sub *Subscription
+		var err error
+		if isPattern(uri) {
+			sub, err = c.SubscribePattern(uri, s.send, eventTypes...)
+		} else {
+			sub, err = c.Subscribe(uri, s.send, eventTypes...)
+		}
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+		s.subs = append(s.subs, sub)
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-c.done:
+		case <-s.stop:
+		}
+		s.Close()
+	}()
+
+	return s, nil
+}
+
+// Dropped returns the number of events discarded because the channel was full
+func (s *EventStream) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Close unsubscribes the stream and closes its channel. It is safe to call more than once.
+func (s *EventStream) Close() {
+	s.once.Do(func() {
+		close(s.stop)
+		for _, sub := range s.subs {
+			sub.Unsubscribe()
+		}
+
+		s.mu.Lock()
+		s.closed = true
+		close(s.ch)
+		s.mu.Unlock()
+	})
+}
+
+// send is the handler of every subscription of the stream
+func (s *EventStream) send(event *Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// An event matching several URIs of the filter is delivered once. Its
+	// handlers run back to back on the same dispatcher worker.
+	if s.closed || event == s.last {
+		return
+	}
+	s.last = event
+
+	select {
+	case s.ch <- event:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// isPattern reports whether uri should be subscribed with SubscribePattern
+func isPattern(uri string) bool {
+	return strings.HasPrefix(uri, "^") || strings.ContainsAny(uri, "*?[")
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/its-haze/lcu-gopher/liveclient"
+)
+
+func main() {
+	client := liveclient.NewClient(liveclient.DefaultConfig())
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Println("Waiting for game events... (Press Ctrl+C to exit)")
+
+	for event := range client.Events(ctx) {
+		switch event.EventName {
+		case liveclient.EventChampionKill:
+			fmt.Printf("[%.0fs] %s killed %s\n", event.EventTime, event.KillerName, event.VictimName)
+		case liveclient.EventDragonKill:
+			fmt.Printf("[%.0fs] %s dragon taken by %s\n", event.EventTime, event.DragonType, event.KillerName)
+		case liveclient.EventGameEnd:
+			fmt.Printf("Game over: %s\n", event.Result)
+		default:
+			fmt.Printf("[%.0fs] %s\n", event.EventTime, event.EventName)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -34,14 +35,21 @@ func main() {
 	}
 	fmt.Println("Successfully connected to LCU Api")
 
-	if err := client.Subscribe("/lol-summoner/v1/current-summoner", handleSummonerUpdate, lcu.EventTypeUpdate); err != nil {
+	// Cancelled on Ctrl+C, which also closes the event stream
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	events, err := client.Events(ctx, lcu.EventFilter{
+		URIs:       []string{"/lol-summoner/v1/current-summoner"},
+		EventTypes: []lcu.EventType{lcu.EventTypeUpdate},
+	})
+	if err != nil {
 		log.Fatal(err)
 	}
 
 	fmt.Println("Change your profile picture... (Press Ctrl+C to exit)")
 
-	// Wait for interrupt
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-	<-sigChan
+	for event := range events.C {
+		handleSummonerUpdate(event)
+	}
 }
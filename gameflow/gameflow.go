@@ -0,0 +1,346 @@
+// Package gameflow tracks the gameflow phase of the League client as a
+// state machine. It remembers the current and previous phase and how long
+// each phase lasted, and runs hooks when a phase is entered or left.
+//
+//	machine := gameflow.New(client)
+//	machine.OnEnter(lcu.GamePhaseReadyCheck, func(t gameflow.Transition) {
+//		fmt.Println("match found after", t.Duration, "in", t.From)
+//	})
+//	if err := machine.Start(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+//	defer machine.Stop()
+package gameflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	lcu "github.com/its-haze/lcu-gopher"
+)
+
+// phaseEndpoint is the event URI the gameflow phase is published on
+const phaseEndpoint = "/lol-gameflow/v1/gameflow-phase"
+
+// historySize is the number of transitions History keeps
+const historySize = 64
+
+// Any matches every phase when registering OnTransition hooks
+const Any lcu.GamePhase = "*"
+
+// Transition describes a change from one phase to another
+type Transition struct {
+	From     lcu.GamePhase // Phase that was left, empty for the first state
+	To       lcu.GamePhase // Phase that was entered
+	Duration time.Duration // How long From lasted
+	At       time.Time     // When the transition was observed
+	Seeded   bool          // Whether the phase was fetched rather than received as an event
+}
+
+// State is a snapshot of the machine
+type State struct {
+	Phase            lcu.GamePhase // Current phase, empty before the machine is seeded
+	Previous         lcu.GamePhase // Phase before the current one
+	EnteredAt        time.Time     // When the current phase was entered
+	PreviousDuration time.Duration // How long the previous phase lasted
+}
+
+// Hook is called with the transition that triggered it
+type Hook func(Transition)
+
+// hook is a registered Hook and the phases it runs for
+type hook struct {
+	id   uint64
+	from lcu.GamePhase
+	to   lcu.GamePhase
+	fn   Hook
+}
+
+// Machine follows the gameflow phase of a client. Hooks run one at a time,
+// in the order the transitions happened, in the order OnExit, OnTransition,
+// OnEnter.
+type Machine struct {
+	client *lcu.Client
+	logger lcu.Logger
+
+	mu      sync.RWMutex
+	state   State
+	history []Transition
+	exit    []hook
+	trans   []hook
+	enter   []hook
+	hookSeq uint64
+
+	// applyMu serialises transitions so hooks never run concurrently
+	applyMu sync.Mutex
+	version uint64 // incremented for every phase received as an event
+
+	subs   []*lcu.Subscription
+	cancel context.CancelFunc
+}
+
+// New creates a machine for client. It does nothing until Start is called.
+func New(client *lcu.Client) *Machine {
+	return &Machine{
+		client: client,
+		logger: client.Logger(),
+	}
+}
+
+// Start subscribes to gameflow phase events and seeds the current phase
+// from GetGameSession. The phase is fetched again whenever the client
+// reconnects, as events may have been missed while it was disconnected.
+//
+// Parameters:
+//   - ctx: Context for the initial fetch; cancelling it also stops refetching on reconnect
+//
+// Returns an error if:
+//   - The machine is already started
+//   - Failed to subscribe to gameflow phase events
+//   - Failed to fetch the game session
+func (m *Machine) Start(ctx context.Context) error {
+	m.mu.Lock()
+	if m.cancel != nil {
+		m.mu.Unlock()
+		return errors.New("gameflow machine already started")
+	}
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.mu.Unlock()
+
+	phaseSub, err := lcu.SubscribeTypedPattern(m.client, phaseEndpoint, func(event *lcu.TypedEvent[lcu.GamePhase]) {
+		if event.Data != "" {
+			m.apply(event.Data)
+		}
+	}, lcu.EventTypeCreate, lcu.EventTypeUpdate)
+	if err != nil {
+		m.Stop()
+		return fmt.Errorf("failed to subscribe to gameflow phase: %w", err)
+	}
+
+	stateSub := m.client.SubscribeToConnectionState(func(state lcu.ConnectionState) {
+		if state != lcu.ConnectionStateConnected {
+			return
+		}
+		go func() {
+			if err := m.seed(ctx); err != nil && ctx.Err() == nil {
+				m.logger.Error("gameflow", "Failed to refresh gameflow phase: %v", err)
+			}
+		}()
+	})
+
+	m.mu.Lock()
+	m.subs = []*lcu.Subscription{phaseSub, stateSub}
+	m.mu.Unlock()
+
+	if err := m.seed(ctx); err != nil {
+		m.Stop()
+		return err
+	}
+	return nil
+}
+
+// Stop unsubscribes from the client. Hooks and state are kept, so the
+// machine can be started again.
+func (m *Machine) Stop() error {
+	m.mu.Lock()
+	subs := m.subs
+	m.subs = nil
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
+	m.mu.Unlock()
+
+	var firstErr error
+	for _, sub := range subs {
+		if err := sub.Unsubscribe(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// seed fetches the current phase. A phase received as an event while the
+// request was in flight is newer, so the fetched one is then dropped.
+func (m *Machine) seed(ctx context.Context) error {
+	m.applyMu.Lock()
+	version := m.version
+	m.applyMu.Unlock()
+
+	phase := lcu.GamePhaseNone
+	session, err := m.client.GetGameSessionContext(ctx)
+	switch {
+	case errors.Is(err, lcu.ErrSummonerNotInGame):
+		// No gameflow session exists outside of a lobby or game
+	case err != nil:
+		return err
+	case session.Phase != "":
+		phase = lcu.GamePhase(session.Phase)
+	}
+
+	m.applyMu.Lock()
+	defer m.applyMu.Unlock()
+	if m.version != version {
+		return nil
+	}
+	m.transition(phase, true)
+	return nil
+}
+
+// apply moves the machine to a phase received as an event
+func (m *Machine) apply(phase lcu.GamePhase) {
+	m.applyMu.Lock()
+	defer m.applyMu.Unlock()
+	m.version++
+	m.transition(phase, false)
+}
+
+// transition records the move to phase and runs the matching hooks.
+// The caller must hold applyMu.
+func (m *Machine) transition(phase lcu.GamePhase, seeded bool) {
+	now := time.Now()
+
+	m.mu.Lock()
+	if m.state.Phase == phase {
+		m.mu.Unlock()
+		return
+	}
+
+	t := Transition{
+		From:   m.state.Phase,
+		To:     phase,
+		At:     now,
+		Seeded: seeded,
+	}
+	if !m.state.EnteredAt.IsZero() {
+		t.Duration = now.Sub(m.state.EnteredAt)
+	}
+
+	m.state = State{
+		Phase:            phase,
+		Previous:         t.From,
+		EnteredAt:        now,
+		PreviousDuration: t.Duration,
+	}
+	m.history = append(m.history, t)
+	if len(m.history) > historySize {
+		m.history = m.history[len(m.history)-historySize:]
+	}
+
+	var hooks []Hook
+	for _, h := range m.exit {
+		if t.From != "" && h.from == t.From {
+			hooks = append(hooks, h.fn)
+		}
+	}
+	for _, h := range m.trans {
+		if (h.from == Any || h.from == t.From) && (h.to == Any || h.to == t.To) {
+			hooks = append(hooks, h.fn)
+		}
+	}
+	for _, h := range m.enter {
+		if h.to == t.To {
+			hooks = append(hooks, h.fn)
+		}
+	}
+	m.mu.Unlock()
+
+	for _, fn := range hooks {
+		m.run(fn, t)
+	}
+}
+
+// run calls a hook, recovering from panics so one hook cannot stop the others
+func (m *Machine) run(fn Hook, t Transition) {
+	defer func() {
+		if r := recover(); r != nil {
+			m.logger.Error("gameflow", "Hook for %q -> %q panicked: %v", t.From, t.To, r)
+		}
+	}()
+	fn(t)
+}
+
+// OnEnter registers a hook that runs whenever phase is entered, including
+// when the machine is first seeded in that phase.
+//
+// Returns a function that removes the hook.
+func (m *Machine) OnEnter(phase lcu.GamePhase, fn Hook) func() {
+	return m.addHook(&m.enter, "", phase, fn)
+}
+
+// OnExit registers a hook that runs whenever phase is left.
+//
+// Returns a function that removes the hook.
+func (m *Machine) OnExit(phase lcu.GamePhase, fn Hook) func() {
+	return m.addHook(&m.exit, phase, "", fn)
+}
+
+// OnTransition registers a hook that runs when the phase changes from one
+// phase to another. Either phase may be Any. The first state has an empty
+// From, so it only matches Any.
+//
+// Returns a function that removes the hook.
+func (m *Machine) OnTransition(from, to lcu.GamePhase, fn Hook) func() {
+	return m.addHook(&m.trans, from, to, fn)
+}
+
+func (m *Machine) addHook(list *[]hook, from, to lcu.GamePhase, fn Hook) func() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.hookSeq++
+	id := m.hookSeq
+	*list = append(*list, hook{id: id, from: from, to: to, fn: fn})
+
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for i, h := range *list {
+			if h.id == id {
+				*list = append((*list)[:i:i], (*list)[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Current returns the current phase, or an empty phase before the machine is seeded
+func (m *Machine) Current() lcu.GamePhase {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state.Phase
+}
+
+// Previous returns the phase before the current one
+func (m *Machine) Previous() lcu.GamePhase {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state.Previous
+}
+
+// Elapsed returns how long the machine has been in the current phase
+func (m *Machine) Elapsed() time.Duration {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.state.EnteredAt.IsZero() {
+		return 0
+	}
+	return time.Since(m.state.EnteredAt)
+}
+
+// State returns a snapshot of the current state
+func (m *Machine) State() State {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state
+}
+
+// History returns the most recent transitions, oldest first
+func (m *Machine) History() []Transition {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]Transition(nil), m.history...)
+}
@@ -1,137 +1,174 @@
 package lcu
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
+	"net/url"
 )
 
 // GetCurrentSummoner retrieves information about the currently logged-in summoner
 func (c *Client) GetCurrentSummoner() (*Summoner, error) {
-	resp, err := c.Get("/lol-summoner/v1/current-summoner")
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get summoner info: status %d", resp.StatusCode)
-	}
+	return c.GetCurrentSummonerContext(context.Background())
+}
 
-	var summoner Summoner
-	if err := json.NewDecoder(resp.Body).Decode(&summoner); err != nil {
-		return nil, fmt.Errorf("failed to decode summoner: %w", err)
+// GetCurrentSummonerContext is like GetCurrentSummoner but uses the provided context
+func (c *Client) GetCurrentSummonerContext(ctx context.Context) (*Summoner, error) {
+	summoner, err := GetJSONContext[Summoner](ctx, c, "/lol-summoner/v1/current-summoner")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get summoner info: %w", err)
 	}
 
-	return &summoner, nil
+	return summoner, nil
 }
 
 // GetSummonerByName retrieves summoner information by name
 func (c *Client) GetSummonerByName(name string) (*Summoner, error) {
-	resp, err := c.Get("/lol-summoner/v1/summoners?name=" + name)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: status %d", ErrSummonerNotFound, resp.StatusCode)
-	}
+	return c.GetSummonerByNameContext(context.Background(), name)
+}
 
-	var summoner Summoner
-	if err := json.NewDecoder(resp.Body).Decode(&summoner); err != nil {
-		return nil, fmt.Errorf("failed to decode summoner: %w", err)
+// GetSummonerByNameContext is like GetSummonerByName but uses the provided context
+func (c *Client) GetSummonerByNameContext(ctx context.Context, name string) (*Summoner, error) {
+	summoner, err := GetJSONContext[Summoner](ctx, c, "/lol-summoner/v1/summoners?name="+url.QueryEscape(name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get summoner: %w", err)
 	}
 
-	return &summoner, nil
+	return summoner, nil
 }
 
 // GetChampSelectSession retrieves the current champion select session
 func (c *Client) GetChampSelectSession() (*ChampSelectSession, error) {
-	resp, err := c.Get("/lol-champ-select/v1/session")
+	return c.GetChampSelectSessionContext(context.Background())
+}
+
+// GetChampSelectSessionContext is like GetChampSelectSession but uses the provided context
+func (c *Client) GetChampSelectSessionContext(ctx context.Context) (*ChampSelectSession, error) {
+	session, err := GetJSONContext[ChampSelectSession](ctx, c, "/lol-champ-select/v1/session")
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get champion select session: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("not in champion select")
-	}
+	return session, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get champion select session: status %d", resp.StatusCode)
+// SelectChampion hovers championID for a champion select action, or sets
+// the ban of a ban action, without locking it in
+func (c *Client) SelectChampion(actionID, championID int) error {
+	return c.SelectChampionContext(context.Background(), actionID, championID)
+}
+
+// SelectChampionContext is like SelectChampion but uses the provided context
+func (c *Client) SelectChampionContext(ctx context.Context, actionID, championID int) error {
+	endpoint := fmt.Sprintf("/lol-champ-select/v1/session/actions/%d", actionID)
+	body := map[string]int{"championId": championID}
+	if _, err := DoJSON[map[string]int, struct{}](ctx, c, "PATCH", endpoint, body); err != nil {
+		return fmt.Errorf("failed to select champion: %w", err)
 	}
 
-	var session ChampSelectSession
-	if err := json.NewDecoder(resp.Body).Decode(&session); err != nil {
-		return nil, fmt.Errorf("failed to decode champion select session: %w", err)
+	return nil
+}
+
+// CompleteChampSelectAction locks in the champion selected for a champion select action
+func (c *Client) CompleteChampSelectAction(actionID int) error {
+	return c.CompleteChampSelectActionContext(context.Background(), actionID)
+}
+
+// CompleteChampSelectActionContext is like CompleteChampSelectAction but uses the provided context
+func (c *Client) CompleteChampSelectActionContext(ctx context.Context, actionID int) error {
+	endpoint := fmt.Sprintf("/lol-champ-select/v1/session/actions/%d/complete", actionID)
+	if _, err := DoJSON[*struct{}, struct{}](ctx, c, "POST", endpoint, nil); err != nil {
+		return fmt.Errorf("failed to complete champion select action: %w", err)
 	}
 
-	return &session, nil
+	return nil
 }
 
 // GetFriendsList retrieves the friends list
 func (c *Client) GetFriendsList() ([]Friend, error) {
-	resp, err := c.Get("/lol-chat/v1/friends")
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get friends list: status %d", resp.StatusCode)
-	}
+	return c.GetFriendsListContext(context.Background())
+}
 
-	var friends []Friend
-	if err := json.NewDecoder(resp.Body).Decode(&friends); err != nil {
-		return nil, fmt.Errorf("failed to decode friends list: %w", err)
+// GetFriendsListContext is like GetFriendsList but uses the provided context
+func (c *Client) GetFriendsListContext(ctx context.Context) ([]Friend, error) {
+	friends, err := GetJSONContext[[]Friend](ctx, c, "/lol-chat/v1/friends")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get friends list: %w", err)
 	}
 
-	return friends, nil
+	return *friends, nil
 }
 
 // GetLobby retrieves the current lobby information
 func (c *Client) GetLobby() (*Lobby, error) {
-	resp, err := c.Get("/lol-lobby/v2/lobby")
+	return c.GetLobbyContext(context.Background())
+}
+
+// GetLobbyContext is like GetLobby but uses the provided context
+func (c *Client) GetLobbyContext(ctx context.Context) (*Lobby, error) {
+	lobby, err := GetJSONContext[Lobby](ctx, c, "/lol-lobby/v2/lobby")
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get lobby: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("not in a lobby")
-	}
+	return lobby, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get lobby: status %d", resp.StatusCode)
-	}
+// GetMatchmakingSearchState retrieves the current matchmaking search state
+func (c *Client) GetMatchmakingSearchState() (*MatchmakingSearchState, error) {
+	return c.GetMatchmakingSearchStateContext(context.Background())
+}
 
-	var lobby Lobby
-	if err := json.NewDecoder(resp.Body).Decode(&lobby); err != nil {
-		return nil, fmt.Errorf("failed to decode lobby: %w", err)
+// GetMatchmakingSearchStateContext is like GetMatchmakingSearchState but uses the provided context
+func (c *Client) GetMatchmakingSearchStateContext(ctx context.Context) (*MatchmakingSearchState, error) {
+	state, err := GetJSONContext[MatchmakingSearchState](ctx, c, "/lol-lobby/v2/lobby/matchmaking/search-state")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matchmaking search state: %w", err)
 	}
 
-	return &lobby, nil
+	return state, nil
 }
 
-// GetMatchmakingSearchState retrieves the current matchmaking search state
-func (c *Client) GetMatchmakingSearchState() (*MatchmakingSearchState, error) {
-	resp, err := c.Get("/lol-lobby/v2/lobby/matchmaking/search-state")
+// GetReadyCheck retrieves the ready check of the match that was found
+func (c *Client) GetReadyCheck() (*ReadyCheck, error) {
+	return c.GetReadyCheckContext(context.Background())
+}
+
+// GetReadyCheckContext is like GetReadyCheck but uses the provided context
+func (c *Client) GetReadyCheckContext(ctx context.Context) (*ReadyCheck, error) {
+	check, err := GetJSONContext[ReadyCheck](ctx, c, "/lol-matchmaking/v1/ready-check")
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get ready check: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get matchmaking search state: status %d", resp.StatusCode)
+	return check, nil
+}
+
+// AcceptReadyCheck accepts the ready check of the match that was found
+func (c *Client) AcceptReadyCheck() error {
+	return c.AcceptReadyCheckContext(context.Background())
+}
+
+// AcceptReadyCheckContext is like AcceptReadyCheck but uses the provided context
+func (c *Client) AcceptReadyCheckContext(ctx context.Context) error {
+	if _, err := DoJSON[*struct{}, struct{}](ctx, c, "POST", "/lol-matchmaking/v1/ready-check/accept", nil); err != nil {
+		return fmt.Errorf("failed to accept ready check: %w", err)
 	}
 
-	var state MatchmakingSearchState
-	if err := json.NewDecoder(resp.Body).Decode(&state); err != nil {
-		return nil, fmt.Errorf("failed to decode matchmaking search state: %w", err)
+	return nil
+}
+
+// DeclineReadyCheck declines the ready check of the match that was found
+func (c *Client) DeclineReadyCheck() error {
+	return c.DeclineReadyCheckContext(context.Background())
+}
+
+// DeclineReadyCheckContext is like DeclineReadyCheck but uses the provided context
+func (c *Client) DeclineReadyCheckContext(ctx context.Context) error {
+	if _, err := DoJSON[*struct{}, struct{}](ctx, c, "POST", "/lol-matchmaking/v1/ready-check/decline", nil); err != nil {
+		return fmt.Errorf("failed to decline ready check: %w", err)
 	}
 
-	return &state, nil
+	return nil
 }
 
 // Common position constants
@@ -160,53 +197,42 @@ type RankedQueueStats struct {
 
 // GetRankedStats retrieves the ranked statistics for the current summoner
 func (c *Client) GetRankedStats() (*RankedStats, error) {
-	resp, err := c.Get("/lol-ranked/v1/current-ranked-stats")
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get ranked stats: status %d", resp.StatusCode)
-	}
+	return c.GetRankedStatsContext(context.Background())
+}
 
-	var stats RankedStats
-	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
-		return nil, fmt.Errorf("failed to decode ranked stats: %w", err)
+// GetRankedStatsContext is like GetRankedStats but uses the provided context
+func (c *Client) GetRankedStatsContext(ctx context.Context) (*RankedStats, error) {
+	stats, err := GetJSONContext[RankedStats](ctx, c, "/lol-ranked/v1/current-ranked-stats")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ranked stats: %w", err)
 	}
 
-	return &stats, nil
+	return stats, nil
 }
 
 // GetGameSession returns the current game session information
 func (c *Client) GetGameSession() (*GameSession, error) {
-	resp, err := c.Get("/lol-gameflow/v1/session")
+	return c.GetGameSessionContext(context.Background())
+}
+
+// GetGameSessionContext is like GetGameSession but uses the provided context
+func (c *Client) GetGameSessionContext(ctx context.Context) (*GameSession, error) {
+	session, err := GetJSONContext[GameSession](ctx, c, "/lol-gameflow/v1/session")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get game session: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
 
-	var session GameSession
-	if err := json.NewDecoder(resp.Body).Decode(&session); err != nil {
-		return nil, fmt.Errorf("failed to decode game session: %w", err)
-	}
-
-	return &session, nil
+	return session, nil
 }
 
 // SubscribeToGamePhase subscribes to game phase changes
-func (c *Client) SubscribeToGamePhase(handler func(phase GamePhase)) error {
+func (c *Client) SubscribeToGamePhase(handler func(phase GamePhase)) (*Subscription, error) {
 	return c.Subscribe("/lol-gameflow/v1/session", func(event *Event) {
-		if event.EventType == string(EventTypeUpdate) {
-			if data, ok := event.Data.(map[string]interface{}); ok {
-				if phase, ok := data["phase"].(string); ok {
-					handler(GamePhase(phase))
-				}
-			}
+		var session struct {
+			Phase GamePhase `json:"phase"`
+		}
+		if err := event.Decode(&session); err == nil && session.Phase != "" {
+			handler(session.Phase)
 		}
 	}, EventTypeUpdate)
 }
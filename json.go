@@ -0,0 +1,146 @@
+package lcu
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+)
+
+// defaultSuccessCodes are the status codes DoJSON accepts when none are given
+var defaultSuccessCodes = []int{http.StatusOK, http.StatusCreated, http.StatusNoContent}
+
+// GetJSON performs a GET request and decodes the JSON response into a T.
+// It is meant for LCU endpoints that the library does not wrap:
+//
+//	type Wallet struct {
+//		BE  int `json:"lol_blue_essence"`
+//		RP  int `json:"RP"`
+//	}
+//	wallet, err := lcu.GetJSON[Wallet](client, "/lol-inventory/v1/wallet/0")
+//
+// Returns an *APIError if the status code is not 200, 201 or 204.
+func GetJSON[T any](c *Client, endpoint string) (*T, error) {
+	return GetJSONContext[T](context.Background(), c, endpoint)
+}
+
+// GetJSONContext is like GetJSON but uses the provided context
+func GetJSONContext[T any](ctx context.Context, c *Client, endpoint string) (*T, error) {
+	return doJSON[T](ctx, c, "GET", endpoint, nil, nil)
+}
+
+// PostJSON performs a POST request with body encoded as JSON and decodes the JSON response into a Resp
+func PostJSON[Req, Resp any](c *Client, endpoint string, body Req) (*Resp, error) {
+	return PostJSONContext[Req, Resp](context.Background(), c, endpoint, body)
+}
+
+// PostJSONContext is like PostJSON but uses the provided context
+func PostJSONContext[Req, Resp any](ctx context.Context, c *Client, endpoint string, body Req) (*Resp, error) {
+	return DoJSON[Req, Resp](ctx, c, "POST", endpoint, body)
+}
+
+// PutJSON performs a PUT request with body encoded as JSON and decodes the JSON response into a Resp
+func PutJSON[Req, Resp any](c *Client, endpoint string, body Req) (*Resp, error) {
+	return PutJSONContext[Req, Resp](context.Background(), c, endpoint, body)
+}
+
+// PutJSONContext is like PutJSON but uses the provided context
+func PutJSONContext[Req, Resp any](ctx context.Context, c *Client, endpoint string, body Req) (*Resp, error) {
+	return DoJSON[Req, Resp](ctx, c, "PUT", endpoint, body)
+}
+
+// PatchJSON performs a PATCH request with body encoded as JSON and decodes the JSON response into a Resp
+func PatchJSON[Req, Resp any](c *Client, endpoint string, body Req) (*Resp, error) {
+	return PatchJSONContext[Req, Resp](context.Background(), c, endpoint, body)
+}
+
+// PatchJSONContext is like PatchJSON but uses the provided context
+func PatchJSONContext[Req, Resp any](ctx context.Context, c *Client, endpoint string, body Req) (*Resp, error) {
+	return DoJSON[Req, Resp](ctx, c, "PATCH", endpoint, body)
+}
+
+// DeleteJSON performs a DELETE request and decodes the JSON response, if any, into a T
+func DeleteJSON[T any](c *Client, endpoint string) (*T, error) {
+	return DeleteJSONContext[T](context.Background(), c, endpoint)
+}
+
+// DeleteJSONContext is like DeleteJSON but uses the provided context
+func DeleteJSONContext[T any](ctx context.Context, c *Client, endpoint string) (*T, error) {
+	return doJSON[T](ctx, c, "DELETE", endpoint, nil, nil)
+}
+
+// DoJSON sends a request with body encoded as JSON and decodes the JSON
+// response into a Resp. A nil body (for pointer, map, slice or interface
+// types) sends no request body. Empty responses, such as 204 No Content,
+// yield a zero Resp.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - c: The client to send the request with
+//   - method: The HTTP method to use (e.g., "POST", "PATCH")
+//   - endpoint: The API endpoint to request
+//   - body: The value to encode as the request body
+//   - successCodes: Accepted status codes (default: 200, 201, 204)
+//
+// Returns an *APIError if the response status is not a success code.
+func DoJSON[Req, Resp any](ctx context.Context, c *Client, method, endpoint string, body Req, successCodes ...int) (*Resp, error) {
+	var reader io.Reader
+	if !isNil(body) {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	return doJSON[Resp](ctx, c, method, endpoint, reader, successCodes)
+}
+
+func doJSON[T any](ctx context.Context, c *Client, method, endpoint string, body io.Reader, successCodes []int) (*T, error) {
+	if len(successCodes) == 0 {
+		successCodes = defaultSuccessCodes
+	}
+
+	resp, err := c.RequestContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if !slices.Contains(successCodes, resp.StatusCode) {
+		return nil, NewAPIError(resp)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var result T
+	if len(bytes.TrimSpace(data)) == 0 {
+		return &result, nil
+	}
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// isNil reports whether v is nil or a nil pointer, map, slice or interface
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
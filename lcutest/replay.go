@@ -0,0 +1,102 @@
+package lcutest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	lcu "github.com/its-haze/lcu-gopher"
+)
+
+// ServeCassette registers the HTTP responses of a recorded cassette. Each
+// method and path answers with its recorded responses in order, repeating
+// the last one once they run out.
+func (s *Server) ServeCassette(entries []lcu.CassetteEntry) {
+	responses := make(map[string][]lcu.CassetteEntry)
+	var keys []string
+	for _, entry := range entries {
+		if entry.Kind != lcu.CassetteHTTP {
+			continue
+		}
+		path, _, _ := strings.Cut(entry.Endpoint, "?")
+		key := entry.Method + " " + path
+		if _, ok := responses[key]; !ok {
+			keys = append(keys, key)
+		}
+		responses[key] = append(responses[key], entry)
+	}
+
+	for _, key := range keys {
+		method, path, _ := strings.Cut(key, " ")
+		queue := responses[key]
+		var mu sync.Mutex
+		s.Handle(method, path, func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			entry := queue[0]
+			if len(queue) > 1 {
+				queue = queue[1:]
+			}
+			mu.Unlock()
+
+			if entry.ResponseBody != "" {
+				w.Header().Set("Content-Type", "application/json")
+			}
+			w.WriteHeader(entry.StatusCode)
+			w.Write([]byte(entry.ResponseBody))
+		})
+	}
+}
+
+// Replay publishes the events received in a recorded cassette to subscribed
+// clients, keeping the recorded gaps between them divided by speed. A speed
+// of 1 replays in real time, 10 ten times faster and 0 or less without delays.
+// Call responses are skipped since their call IDs belong to the recorded session.
+//
+// Replay blocks until all events are published or ctx is done.
+func (s *Server) Replay(ctx context.Context, entries []lcu.CassetteEntry, speed float64) error {
+	var start time.Time
+	began := time.Now()
+
+	for _, entry := range entries {
+		if entry.Kind != lcu.CassetteReceived || !isEvent(entry.Frame) {
+			continue
+		}
+
+		if start.IsZero() {
+			start = entry.Time
+		}
+		if speed > 0 {
+			due := began.Add(time.Duration(float64(entry.Time.Sub(start)) / speed))
+			if wait := time.Until(due); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-timer.C:
+				case <-ctx.Done():
+					timer.Stop()
+					return ctx.Err()
+				}
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.publish(entry.Frame, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isEvent reports whether frame is a WAMP EVENT message
+func isEvent(frame json.RawMessage) bool {
+	var message []json.RawMessage
+	if json.Unmarshal(frame, &message) != nil || len(message) == 0 {
+		return false
+	}
+	var opcode int
+	return json.Unmarshal(message[0], &opcode) == nil && opcode == 8
+}
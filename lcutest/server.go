@@ -0,0 +1,413 @@
+// Package lcutest provides an in-process fake League Client for testing code
+// built on lcu-gopher without a running client.
+//
+// A Server speaks HTTPS and WAMP over a WebSocket on 127.0.0.1, checks the
+// Basic riot:<password> auth the LCU requires, serves scripted responses and
+// lets tests push events:
+//
+//	srv := lcutest.NewServer()
+//	defer srv.Close()
+//
+//	srv.SetResponse("GET", "/lol-gameflow/v1/gameflow-phase", http.StatusOK, "Lobby")
+//
+//	client, err := lcu.NewClient(srv.Config())
+//	...
+//	srv.WaitForSubscription(ctx, "/lol-gameflow/v1/gameflow-phase")
+//	srv.Publish(lcu.EventTypeUpdate, "/lol-gameflow/v1/gameflow-phase", "ChampSelect")
+package lcutest
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	lcu "github.com/its-haze/lcu-gopher"
+)
+
+// Password is the auth token of every Server
+const Password = "lcutest-password"
+
+// eventTopic is the WAMP topic events are published on
+const eventTopic = "OnJsonApiEvent"
+
+// CallHandler answers a WAMP CALL. Returning a *lcu.CallError sends its
+// ErrorURI, Description and Details; any other error is sent as RPC_ERROR.
+type CallHandler func(args []json.RawMessage) (interface{}, error)
+
+// Server is a fake LCU listening on 127.0.0.1
+type Server struct {
+	srv      *httptest.Server
+	upgrader websocket.Upgrader
+
+	mu     sync.Mutex
+	routes map[string]http.HandlerFunc
+	calls  map[string]CallHandler
+	conns  map[*conn]struct{}
+	notify chan struct{} // closed and replaced whenever subscriptions change
+}
+
+// conn is a WebSocket connection of a client
+type conn struct {
+	ws      *websocket.Conn
+	writeMu sync.Mutex
+	topics  map[string]bool // guarded by Server.mu
+}
+
+func (c *conn) write(message interface{}) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.ws.WriteJSON(message)
+}
+
+// NewServer starts a fake LCU. It answers the health endpoints of the LCU and
+// the Riot Client, /lol-summoner/v1/current-summoner with a placeholder
+// summoner and /riotclient/region-locale with en_US/NA.
+func NewServer() *Server {
+	s := &Server{
+		upgrader: websocket.Upgrader{Subprotocols: []string{"wamp"}},
+		routes:   make(map[string]http.HandlerFunc),
+		calls:    make(map[string]CallHandler),
+		conns:    make(map[*conn]struct{}),
+		notify:   make(chan struct{}),
+	}
+	s.srv = httptest.NewUnstartedServer(http.HandlerFunc(s.serveHTTP))
+	s.srv.Config.ErrorLog = log.New(io.Discard, "", 0) // clients skip certificate checks, handshake noise is expected
+	s.srv.StartTLS()
+
+	s.SetResponse("GET", "/lol-summoner/v1/current-summoner", http.StatusOK, lcu.Summoner{
+		AccountID:     1,
+		DisplayName:   "lcutest",
+		GameName:      "lcutest",
+		TagLine:       "TEST",
+		Puuid:         "00000000-0000-0000-0000-000000000000",
+		SummonerID:    1,
+		SummonerLevel: 30,
+	})
+	// The Riot Client's health endpoint, used by lcu.NewRiotClient
+	s.SetResponse("GET", "/riotclient/region-locale", http.StatusOK, lcu.RegionLocale{
+		Locale:      "en_US",
+		Region:      "NA",
+		WebLanguage: "en",
+		WebRegion:   "na",
+	})
+	return s
+}
+
+// Close shuts down the server and drops all connections
+func (s *Server) Close() {
+	s.DropConnections()
+	s.srv.Close()
+}
+
+// URL returns the base URL of the server, e.g. https://127.0.0.1:54321
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Port returns the port the server listens on
+func (s *Server) Port() int {
+	u, _ := url.Parse(s.srv.URL)
+	port, _ := strconv.Atoi(u.Port())
+	return port
+}
+
+// Credentials returns the credentials a client needs to connect to the server
+func (s *Server) Credentials() *lcu.Credentials {
+	return &lcu.Credentials{
+		Port:        s.Port(),
+		Password:    Password,
+		Protocol:    "https",
+		ProcessName: "LeagueClient",
+		PID:         os.Getpid(),
+	}
+}
+
+// Config returns the default client configuration pointed at the server
+// through injected credentials, so no lockfile or process is needed
+func (s *Server) Config() *lcu.Config {
+	config := lcu.DefaultConfig()
+	config.Host = "127.0.0.1"
+	config.Credentials = s.Credentials()
+	config.ReconnectBackoff = 50 * time.Millisecond
+	config.MaxReconnectBackoff = time.Second
+	return config
+}
+
+// Handle registers handler for requests with the given method and path.
+// The query string is not part of the match.
+func (s *Server) Handle(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[method+" "+path] = handler
+}
+
+// SetResponse makes requests with the given method and path answer with
+// status and body. A []byte body is sent as is; anything else is encoded as JSON.
+func (s *Server) SetResponse(method, path string, status int, body interface{}) {
+	data, ok := body.([]byte)
+	if !ok && body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			panic(fmt.Sprintf("lcutest: failed to encode response for %s %s: %v", method, path, err))
+		}
+	}
+
+	s.Handle(method, path, func(w http.ResponseWriter, r *http.Request) {
+		if len(data) > 0 {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.WriteHeader(status)
+		w.Write(data)
+	})
+}
+
+// HandleCall registers handler for WAMP CALLs of procedure
+func (s *Server) HandleCall(procedure string, handler CallHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[procedure] = handler
+}
+
+// Publish sends an event to every client subscribed to the event bus
+func (s *Server) Publish(eventType lcu.EventType, uri string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode event data: %w", err)
+	}
+
+	return s.publish([]interface{}{8, eventTopic, map[string]interface{}{
+		"eventType": string(eventType),
+		"uri":       uri,
+		"data":      json.RawMessage(payload),
+	}}, true)
+}
+
+// PublishRaw sends frame unchanged to every connected client, subscribed or
+// not. It is meant for malformed or unusual messages.
+func (s *Server) PublishRaw(frame []byte) error {
+	return s.publish(json.RawMessage(frame), false)
+}
+
+func (s *Server) publish(message interface{}, subscribedOnly bool) error {
+	s.mu.Lock()
+	var conns []*conn
+	for c := range s.conns {
+		if !subscribedOnly || c.topics[eventTopic] {
+			conns = append(conns, c)
+		}
+	}
+	s.mu.Unlock()
+
+	var firstErr error
+	for _, c := range conns {
+		if err := c.write(message); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Subscriptions returns the topics any client is subscribed to
+func (s *Server) Subscriptions() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]bool)
+	var topics []string
+	for c := range s.conns {
+		for topic := range c.topics {
+			if !seen[topic] {
+				seen[topic] = true
+				topics = append(topics, topic)
+			}
+		}
+	}
+	return topics
+}
+
+// WaitForSubscription blocks until a client is subscribed to topic or ctx is done.
+// Events published before the subscription arrives are not delivered.
+func (s *Server) WaitForSubscription(ctx context.Context, topic string) error {
+	for {
+		s.mu.Lock()
+		notify := s.notify
+		for c := range s.conns {
+			if c.topics[topic] {
+				s.mu.Unlock()
+				return nil
+			}
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-notify:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Connections returns the number of open WebSocket connections
+func (s *Server) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.conns)
+}
+
+// DropConnections closes every WebSocket connection, as the LCU does when it exits
+func (s *Server) DropConnections() {
+	s.mu.Lock()
+	conns := s.conns
+	s.conns = make(map[*conn]struct{})
+	s.changed()
+	s.mu.Unlock()
+
+	for c := range conns {
+		c.ws.Close()
+	}
+}
+
+// changed wakes up WaitForSubscription. The caller must hold s.mu.
+func (s *Server) changed() {
+	close(s.notify)
+	s.notify = make(chan struct{})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	user, password, ok := r.BasicAuth()
+	if !ok || user != "riot" || subtle.ConstantTimeCompare([]byte(password), []byte(Password)) != 1 {
+		w.Header().Set("WWW-Authenticate", `Basic realm="LCU"`)
+		writeError(w, http.StatusUnauthorized, "RPC_ERROR", "Invalid credentials")
+		return
+	}
+
+	if websocket.IsWebSocketUpgrade(r) {
+		s.serveWebSocket(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	handler, ok := s.routes[r.Method+" "+r.URL.Path]
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", "Invalid URI: "+r.URL.Path)
+		return
+	}
+	handler(w, r)
+}
+
+// writeError writes an error in the LCU's error payload format
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"errorCode":  code,
+		"httpStatus": status,
+		"message":    message,
+	})
+}
+
+func (s *Server) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	ws, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	c := &conn{ws: ws, topics: make(map[string]bool)}
+	s.mu.Lock()
+	s.conns[c] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		if _, ok := s.conns[c]; ok {
+			delete(s.conns, c)
+			s.changed()
+		}
+		s.mu.Unlock()
+		ws.Close()
+	}()
+
+	for {
+		_, data, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var message []json.RawMessage
+		if json.Unmarshal(data, &message) != nil || len(message) < 2 {
+			continue
+		}
+		var opcode int
+		if json.Unmarshal(message[0], &opcode) != nil {
+			continue
+		}
+
+		switch opcode {
+		case 2: // CALL: [2, callID, procedure, args...]
+			s.handleCall(c, message)
+		case 5, 6: // SUBSCRIBE / UNSUBSCRIBE: [5|6, topic]
+			var topic string
+			if json.Unmarshal(message[1], &topic) != nil {
+				continue
+			}
+			s.mu.Lock()
+			if opcode == 5 {
+				c.topics[topic] = true
+			} else {
+				delete(c.topics, topic)
+			}
+			s.changed()
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *Server) handleCall(c *conn, message []json.RawMessage) {
+	if len(message) < 3 {
+		return
+	}
+	callID := message[1]
+	var procedure string
+	if json.Unmarshal(message[2], &procedure) != nil {
+		return
+	}
+
+	s.mu.Lock()
+	handler, ok := s.calls[procedure]
+	s.mu.Unlock()
+
+	if !ok {
+		c.write([]interface{}{4, callID, "RPC_ERROR", "Unknown procedure " + procedure})
+		return
+	}
+
+	result, err := handler(message[3:])
+	if err != nil {
+		var callErr *lcu.CallError
+		if errors.As(err, &callErr) {
+			reply := []interface{}{4, callID, callErr.ErrorURI, callErr.Description}
+			if len(callErr.Details) > 0 {
+				reply = append(reply, callErr.Details)
+			}
+			c.write(reply)
+			return
+		}
+		c.write([]interface{}{4, callID, "RPC_ERROR", err.Error()})
+		return
+	}
+	c.write([]interface{}{3, callID, result})
+}
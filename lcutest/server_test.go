@@ -0,0 +1,212 @@
+package lcutest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	lcu "github.com/its-haze/lcu-gopher"
+	"github.com/its-haze/lcu-gopher/lcutest"
+)
+
+// nopLogger discards all log output
+type nopLogger struct{}
+
+func (nopLogger) Info(endpoint, msg string, args ...interface{})  {}
+func (nopLogger) Error(endpoint, msg string, args ...interface{}) {}
+func (nopLogger) Debug(endpoint, msg string, args ...interface{}) {}
+
+// newClient creates a client for server and connects it if connect is set
+func newClient(t *testing.T, server *lcutest.Server, config *lcu.Config, connect bool) *lcu.Client {
+	t.Helper()
+
+	config.Logger = nopLogger{}
+	client, err := lcu.NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if connect {
+		if err := client.Connect(); err != nil {
+			t.Fatalf("Connect: %v", err)
+		}
+		t.Cleanup(func() { client.Disconnect() })
+	}
+	return client
+}
+
+func TestServerRejectsBadAuth(t *testing.T) {
+	server := lcutest.NewServer()
+	defer server.Close()
+	server.SetResponse("GET", "/lol-summoner/v1/current-summoner", http.StatusOK, lcu.Summoner{GameName: "Faker"})
+
+	config := server.Config()
+	config.Credentials = &lcu.Credentials{Port: server.Port(), Password: "wrong-password", Protocol: "https"}
+	config.Timeout = time.Second
+	client := newClient(t, server, config, false)
+
+	_, err := client.GetCurrentSummoner()
+	var apiErr *lcu.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("GetCurrentSummoner error = %v, want an *lcu.APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", apiErr.StatusCode, http.StatusUnauthorized)
+	}
+
+	if err := client.Connect(); err == nil {
+		client.Disconnect()
+		t.Fatal("Connect succeeded with a wrong password")
+	}
+	if n := server.Connections(); n != 0 {
+		t.Fatalf("Connections = %d after a rejected handshake, want 0", n)
+	}
+}
+
+func TestServerSetResponse(t *testing.T) {
+	server := lcutest.NewServer()
+	defer server.Close()
+	client := newClient(t, server, server.Config(), false)
+
+	server.SetResponse("GET", "/lol-summoner/v1/current-summoner", http.StatusOK, lcu.Summoner{GameName: "Faker", TagLine: "KR1"})
+	summoner, err := client.GetCurrentSummoner()
+	if err != nil {
+		t.Fatalf("GetCurrentSummoner: %v", err)
+	}
+	if summoner.GameName != "Faker" || summoner.TagLine != "KR1" {
+		t.Fatalf("summoner = %s#%s, want Faker#KR1", summoner.GameName, summoner.TagLine)
+	}
+
+	server.SetResponse("GET", "/lol-lobby/v2/lobby", http.StatusNotFound, map[string]interface{}{
+		"errorCode":  "RESOURCE_NOT_FOUND",
+		"httpStatus": http.StatusNotFound,
+		"message":    "LOBBY_NOT_FOUND",
+	})
+	if _, err := client.GetLobby(); !errors.Is(err, lcu.ErrSummonerNotInLobby) {
+		t.Fatalf("GetLobby error = %v, want %v", err, lcu.ErrSummonerNotInLobby)
+	}
+
+	// Unregistered paths answer like the LCU does
+	_, err = lcu.GetJSON[map[string]interface{}](client, "/lol-unknown/v1/endpoint")
+	var apiErr *lcu.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || apiErr.ErrorCode != "RESOURCE_NOT_FOUND" {
+		t.Fatalf("unregistered path error = %v, want a RESOURCE_NOT_FOUND 404", err)
+	}
+}
+
+func TestServerHandle(t *testing.T) {
+	server := lcutest.NewServer()
+	defer server.Close()
+	client := newClient(t, server, server.Config(), false)
+
+	type invitation struct {
+		ToSummonerID int64 `json:"toSummonerId"`
+	}
+
+	server.Handle("POST", "/lol-lobby/v2/lobby/invitations", func(w http.ResponseWriter, r *http.Request) {
+		var invitations []invitation
+		if err := json.NewDecoder(r.Body).Decode(&invitations); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for i := range invitations {
+			invitations[i].ToSummonerID *= 10
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(invitations)
+	})
+
+	got, err := lcu.PostJSON[[]invitation, []invitation](client, "/lol-lobby/v2/lobby/invitations", []invitation{{ToSummonerID: 1}, {ToSummonerID: 2}})
+	if err != nil {
+		t.Fatalf("PostJSON: %v", err)
+	}
+	if len(*got) != 2 || (*got)[0].ToSummonerID != 10 || (*got)[1].ToSummonerID != 20 {
+		t.Fatalf("response = %+v, want summoner IDs 10 and 20", *got)
+	}
+
+	// Routes match on the method too
+	if _, err := lcu.GetJSON[[]invitation](client, "/lol-lobby/v2/lobby/invitations"); err == nil {
+		t.Fatal("GET matched a POST handler")
+	}
+}
+
+func TestServerPublish(t *testing.T) {
+	server := lcutest.NewServer()
+	defer server.Close()
+	client := newClient(t, server, server.Config(), true)
+
+	events := make(chan *lcu.Event, 1)
+	if _, err := client.Subscribe("/lol-gameflow/v1/gameflow-phase", func(event *lcu.Event) {
+		events <- event
+	}, lcu.EventTypeUpdate); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.WaitForSubscription(ctx, "/lol-gameflow/v1/gameflow-phase"); err != nil {
+		t.Fatalf("WaitForSubscription: %v", err)
+	}
+
+	if err := server.Publish(lcu.EventTypeUpdate, "/lol-gameflow/v1/gameflow-phase", "ChampSelect"); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	select {
+	case event := <-events:
+		if event.EventType != string(lcu.EventTypeUpdate) || event.URI != "/lol-gameflow/v1/gameflow-phase" || event.Data != "ChampSelect" {
+			t.Fatalf("event = %s %s %v, want Update /lol-gameflow/v1/gameflow-phase ChampSelect", event.EventType, event.URI, event.Data)
+		}
+	case <-ctx.Done():
+		t.Fatal("published event not received")
+	}
+}
+
+func TestServerDropConnections(t *testing.T) {
+	server := lcutest.NewServer()
+	defer server.Close()
+	client := newClient(t, server, server.Config(), true)
+
+	states := make(chan lcu.ConnectionState, 8)
+	client.SubscribeToConnectionState(func(state lcu.ConnectionState) {
+		states <- state
+	})
+
+	events := make(chan *lcu.Event, 1)
+	if _, err := client.Subscribe("/lol-lobby/v2/lobby", func(event *lcu.Event) {
+		events <- event
+	}, lcu.EventTypeUpdate); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.WaitForSubscription(ctx, "/lol-lobby/v2/lobby"); err != nil {
+		t.Fatalf("WaitForSubscription: %v", err)
+	}
+
+	server.DropConnections()
+
+	// The client reconnects and restores its subscription
+	if err := server.WaitForSubscription(ctx, "/lol-lobby/v2/lobby"); err != nil {
+		t.Fatalf("subscription after reconnect: %v", err)
+	}
+	for state := lcu.ConnectionState(""); state != lcu.ConnectionStateConnected; {
+		select {
+		case state = <-states:
+		case <-ctx.Done():
+			t.Fatal("client did not report the reconnect")
+		}
+	}
+
+	if err := server.Publish(lcu.EventTypeUpdate, "/lol-lobby/v2/lobby", map[string]string{"partyId": "p1"}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	select {
+	case <-events:
+	case <-ctx.Done():
+		t.Fatal("no event received after reconnect")
+	}
+}
@@ -0,0 +1,255 @@
+// Package liveclient is a client for the Live Client Data API the game
+// process serves on https://127.0.0.1:2999 while a game is in progress.
+// ReplayClient controls the replay API served on the same port when a
+// replay is watched.
+//
+// The API needs no credentials and is only available between the loading
+// screen and the end of the game. Requests made outside of a game fail with
+// an error matching lcu.ErrSummonerNotInGame.
+package liveclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	lcu "github.com/its-haze/lcu-gopher"
+)
+
+// Config represents the configuration for the live client
+type Config struct {
+	Host         string        // Host the game listens on
+	Port         int           // Port the game listens on
+	Timeout      time.Duration // HTTP request timeout
+	PollInterval time.Duration // How often Events polls for new game events
+	Logger       lcu.Logger    // Custom logger
+	Debug        bool          // Whether to enable debug logging
+}
+
+// DefaultConfig returns a default configuration
+func DefaultConfig() *Config {
+	return &Config{
+		Host:         "127.0.0.1",
+		Port:         2999,
+		Timeout:      5 * time.Second,
+		PollInterval: time.Second,
+		Logger:       lcu.DefaultConfig().Logger,
+		Debug:        false,
+	}
+}
+
+// Client is a client for the Live Client Data API
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	logger     lcu.Logger
+	config     *Config
+}
+
+// NewClient creates a new live client with the given configuration. No
+// connection is made until the first request, so it can be created before
+// the game starts.
+func NewClient(config *Config) *Client {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	if config.Logger == nil {
+		config.Logger = lcu.DefaultConfig().Logger
+	}
+
+	host := config.Host
+	if host == "" {
+		host = "127.0.0.1"
+	}
+
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: config.Timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true, // The game uses a certificate signed by Riot's own root
+				},
+			},
+		},
+		baseURL: "https://" + net.JoinHostPort(host, strconv.Itoa(config.Port)),
+		logger:  config.Logger,
+		config:  config,
+	}
+}
+
+// Get performs a GET request against the game and decodes the JSON response into v.
+//
+// Returns an error if:
+//   - The game is not running, matching lcu.ErrSummonerNotInGame
+//   - The game responds with an unexpected status code (*lcu.APIError)
+//   - The response could not be decoded
+func (c *Client) Get(endpoint string, v interface{}) error {
+	return c.GetContext(context.Background(), endpoint, v)
+}
+
+// GetContext is like Get but uses the provided context
+func (c *Client) GetContext(ctx context.Context, endpoint string, v interface{}) error {
+	return c.do(ctx, "GET", endpoint, nil, v)
+}
+
+// Post performs a POST request against the game with body encoded as JSON
+// and decodes the JSON response into v, unless v is nil. It fails like Get.
+func (c *Client) Post(endpoint string, body, v interface{}) error {
+	return c.PostContext(context.Background(), endpoint, body, v)
+}
+
+// PostContext is like Post but uses the provided context
+func (c *Client) PostContext(ctx context.Context, endpoint string, body, v interface{}) error {
+	return c.do(ctx, "POST", endpoint, body, v)
+}
+
+func (c *Client) do(ctx context.Context, method, endpoint string, body, v interface{}) error {
+	reqURL := c.baseURL + endpoint
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+
+		if c.config.Debug {
+			c.logger.Debug("liveclient", "Request body: %s", string(data))
+		}
+	}
+
+	if c.config.Debug {
+		c.logger.Debug("liveclient", "Making %s request to %s", method, reqURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// Nothing listens on the port outside of a game
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return fmt.Errorf("%w: %w", lcu.ErrSummonerNotInGame, err)
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return lcu.NewAPIError(resp)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if c.config.Debug {
+		c.logger.Debug("liveclient", "Response body: %s", string(data))
+	}
+
+	if v == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// GetAllGameData returns everything the API knows about the current game
+func (c *Client) GetAllGameData() (*AllGameData, error) {
+	return c.GetAllGameDataContext(context.Background())
+}
+
+// GetAllGameDataContext is like GetAllGameData but uses the provided context
+func (c *Client) GetAllGameDataContext(ctx context.Context) (*AllGameData, error) {
+	var data AllGameData
+	if err := c.GetContext(ctx, "/liveclientdata/allgamedata", &data); err != nil {
+		return nil, fmt.Errorf("failed to get game data: %w", err)
+	}
+
+	return &data, nil
+}
+
+// GetActivePlayer returns the player the game client belongs to
+func (c *Client) GetActivePlayer() (*ActivePlayer, error) {
+	return c.GetActivePlayerContext(context.Background())
+}
+
+// GetActivePlayerContext is like GetActivePlayer but uses the provided context
+func (c *Client) GetActivePlayerContext(ctx context.Context) (*ActivePlayer, error) {
+	var player ActivePlayer
+	if err := c.GetContext(ctx, "/liveclientdata/activeplayer", &player); err != nil {
+		return nil, fmt.Errorf("failed to get active player: %w", err)
+	}
+
+	return &player, nil
+}
+
+// GetPlayerList returns the players in the game. A non-empty team
+// (TeamOrder or TeamChaos) returns only the players of that team.
+func (c *Client) GetPlayerList(team Team) ([]Player, error) {
+	return c.GetPlayerListContext(context.Background(), team)
+}
+
+// GetPlayerListContext is like GetPlayerList but uses the provided context
+func (c *Client) GetPlayerListContext(ctx context.Context, team Team) ([]Player, error) {
+	endpoint := "/liveclientdata/playerlist"
+	if team != "" {
+		endpoint += "?teamID=" + url.QueryEscape(string(team))
+	}
+
+	var players []Player
+	if err := c.GetContext(ctx, endpoint, &players); err != nil {
+		return nil, fmt.Errorf("failed to get player list: %w", err)
+	}
+
+	return players, nil
+}
+
+// GetEventData returns all events of the current game so far
+func (c *Client) GetEventData() ([]GameEvent, error) {
+	return c.GetEventDataContext(context.Background())
+}
+
+// GetEventDataContext is like GetEventData but uses the provided context
+func (c *Client) GetEventDataContext(ctx context.Context) ([]GameEvent, error) {
+	var data EventData
+	if err := c.GetContext(ctx, "/liveclientdata/eventdata", &data); err != nil {
+		return nil, fmt.Errorf("failed to get event data: %w", err)
+	}
+
+	return data.Events, nil
+}
+
+// GetGameStats returns the mode, map and clock of the current game
+func (c *Client) GetGameStats() (*GameStats, error) {
+	return c.GetGameStatsContext(context.Background())
+}
+
+// GetGameStatsContext is like GetGameStats but uses the provided context
+func (c *Client) GetGameStatsContext(ctx context.Context) (*GameStats, error) {
+	var stats GameStats
+	if err := c.GetContext(ctx, "/liveclientdata/gamestats", &stats); err != nil {
+		return nil, fmt.Errorf("failed to get game stats: %w", err)
+	}
+
+	return &stats, nil
+}
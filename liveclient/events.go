@@ -0,0 +1,80 @@
+package liveclient
+
+import (
+	"context"
+	"time"
+)
+
+// Events polls the game every PollInterval and sends each new game event
+// once, in order. Polling continues across games and while no game is
+// running, so the stream can be opened before the game starts. The channel
+// is closed when ctx is done.
+//
+// When the stream is opened during a game, the events that already happened
+// are skipped.
+func (c *Client) Events(ctx context.Context) <-chan GameEvent {
+	events := make(chan GameEvent, 64)
+
+	go func() {
+		defer close(events)
+
+		interval := c.config.PollInterval
+		if interval <= 0 {
+			interval = time.Second
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		lastID := -1
+		skipBacklog := true
+
+		for {
+			gameEvents, err := c.GetEventDataContext(ctx)
+			switch {
+			case ctx.Err() != nil:
+				return
+			case err != nil:
+				if c.config.Debug {
+					c.logger.Debug("liveclient", "Failed to poll game events: %v", err)
+				}
+				// The game is not running yet, so everything it sends is new
+				if lastID < 0 {
+					skipBacklog = false
+				}
+			default:
+				if n := len(gameEvents); n > 0 {
+					newest := gameEvents[n-1].EventID
+					// Event IDs start over in a new game
+					if newest < lastID {
+						lastID = -1
+					}
+					if skipBacklog {
+						lastID = newest
+					}
+				}
+				skipBacklog = false
+
+				for _, event := range gameEvents {
+					if event.EventID <= lastID {
+						continue
+					}
+					lastID = event.EventID
+
+					select {
+					case events <- event:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events
+}
@@ -0,0 +1,130 @@
+package liveclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeGame serves /liveclientdata/eventdata from a list of events that the
+// test can change between polls
+type fakeGame struct {
+	mu     sync.Mutex
+	events []GameEvent
+	polled chan struct{}
+}
+
+func (g *fakeGame) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/liveclientdata/eventdata" {
+		http.NotFound(w, r)
+		return
+	}
+
+	g.mu.Lock()
+	data := EventData{Events: g.events}
+	g.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(data)
+
+	select {
+	case g.polled <- struct{}{}:
+	default:
+	}
+}
+
+func (g *fakeGame) set(events ...GameEvent) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.events = events
+}
+
+// newTestClient creates a client for server that polls every few milliseconds
+func newTestClient(t *testing.T, server *httptest.Server) *Client {
+	t.Helper()
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := strconv.Atoi(u.Port())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := DefaultConfig()
+	config.Host = u.Hostname()
+	config.Port = port
+	config.PollInterval = 10 * time.Millisecond
+	return NewClient(config)
+}
+
+func receive(t *testing.T, ctx context.Context, events <-chan GameEvent) GameEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatal("event stream closed")
+		}
+		return event
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for an event")
+	}
+	return GameEvent{}
+}
+
+func TestEvents(t *testing.T) {
+	game := &fakeGame{polled: make(chan struct{}, 1)}
+	game.set(
+		GameEvent{EventID: 0, EventName: EventGameStart},
+		GameEvent{EventID: 1, EventName: EventMinionsSpawning},
+		GameEvent{EventID: 2, EventName: EventFirstBlood},
+	)
+	server := httptest.NewTLSServer(game)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	events := newTestClient(t, server).Events(ctx)
+
+	// The events that happened before the stream was opened are skipped
+	select {
+	case <-game.polled:
+	case <-ctx.Done():
+		t.Fatal("game never polled")
+	}
+	game.set(
+		GameEvent{EventID: 0, EventName: EventGameStart},
+		GameEvent{EventID: 1, EventName: EventMinionsSpawning},
+		GameEvent{EventID: 2, EventName: EventFirstBlood},
+		GameEvent{EventID: 3, EventName: EventTurretKilled},
+	)
+	if event := receive(t, ctx, events); event.EventID != 3 || event.EventName != EventTurretKilled {
+		t.Fatalf("first event = %d %s, want 3 %s", event.EventID, event.EventName, EventTurretKilled)
+	}
+
+	// Event IDs start over in the next game, whose events are all new
+	game.set(
+		GameEvent{EventID: 0, EventName: EventGameStart},
+		GameEvent{EventID: 1, EventName: EventMinionsSpawning},
+	)
+	for _, want := range []GameEvent{
+		{EventID: 0, EventName: EventGameStart},
+		{EventID: 1, EventName: EventMinionsSpawning},
+	} {
+		if event := receive(t, ctx, events); event.EventID != want.EventID || event.EventName != want.EventName {
+			t.Fatalf("event = %d %s, want %d %s", event.EventID, event.EventName, want.EventID, want.EventName)
+		}
+	}
+
+	cancel()
+	for range events {
+	}
+}
@@ -0,0 +1,435 @@
+package liveclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CameraMode is the camera of the replay
+type CameraMode string
+
+const (
+	CameraTop   CameraMode = "top"   // Default top-down camera
+	CameraFPS   CameraMode = "fps"   // Free camera
+	CameraTPS   CameraMode = "tps"   // Third person camera following the selection
+	CameraFocus CameraMode = "focus" // Camera locked on the selection
+	CameraPath  CameraMode = "path"  // Camera following a sequence
+)
+
+// Vector3 is a position, rotation or offset in the game world
+type Vector3 struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// ReplayGame is the game process the replay is played in
+type ReplayGame struct {
+	ProcessID int `json:"processID"`
+}
+
+// Playback is the playback state of the replay. Times are in seconds.
+type Playback struct {
+	Length  float64 `json:"length"`
+	Paused  bool    `json:"paused"`
+	Seeking bool    `json:"seeking"`
+	Speed   float64 `json:"speed"`
+	Time    float64 `json:"time"`
+}
+
+// PlaybackUpdate changes the playback state. Nil fields are left unchanged.
+type PlaybackUpdate struct {
+	Paused *bool    `json:"paused,omitempty"`
+	Speed  *float64 `json:"speed,omitempty"`
+	Time   *float64 `json:"time,omitempty"`
+}
+
+// Render is the camera and interface of the replay
+type Render struct {
+	CameraMode      CameraMode `json:"cameraMode"`
+	CameraPosition  Vector3    `json:"cameraPosition"`
+	CameraRotation  Vector3    `json:"cameraRotation"`
+	CameraAttached  bool       `json:"cameraAttached"`
+	CameraMoveSpeed float64    `json:"cameraMoveSpeed"`
+	CameraLookSpeed float64    `json:"cameraLookSpeed"`
+	FieldOfView     float64    `json:"fieldOfView"`
+	NearClip        float64    `json:"nearClip"`
+	FarClip         float64    `json:"farClip"`
+	SelectionName   string     `json:"selectionName"`
+	SelectionOffset Vector3    `json:"selectionOffset"`
+
+	FogOfWar            bool `json:"fogOfWar"`
+	OutlineSelect       bool `json:"outlineSelect"`
+	OutlineHover        bool `json:"outlineHover"`
+	FloatingText        bool `json:"floatingText"`
+	Characters          bool `json:"characters"`
+	Environment         bool `json:"environment"`
+	Particles           bool `json:"particles"`
+	Banners             bool `json:"banners"`
+	DepthOfFieldEnabled bool `json:"depthOfFieldEnabled"`
+
+	HealthBarChampions  bool `json:"healthBarChampions"`
+	HealthBarMinions    bool `json:"healthBarMinions"`
+	HealthBarPets       bool `json:"healthBarPets"`
+	HealthBarStructures bool `json:"healthBarStructures"`
+	HealthBarWards      bool `json:"healthBarWards"`
+
+	InterfaceAll           bool `json:"interfaceAll"`
+	InterfaceAnnounce      bool `json:"interfaceAnnounce"`
+	InterfaceChat          bool `json:"interfaceChat"`
+	InterfaceFrames        bool `json:"interfaceFrames"`
+	InterfaceKillCallouts  bool `json:"interfaceKillCallouts"`
+	InterfaceMinimap       bool `json:"interfaceMinimap"`
+	InterfaceNeutralTimers bool `json:"interfaceNeutralTimers"`
+	InterfaceReplay        bool `json:"interfaceReplay"`
+	InterfaceScore         bool `json:"interfaceScore"`
+	InterfaceScoreboard    bool `json:"interfaceScoreboard"`
+	InterfaceTarget        bool `json:"interfaceTarget"`
+	InterfaceTimeline      bool `json:"interfaceTimeline"`
+}
+
+// RenderUpdate changes the camera and interface. Nil fields are left unchanged.
+type RenderUpdate struct {
+	CameraMode      *CameraMode `json:"cameraMode,omitempty"`
+	CameraPosition  *Vector3    `json:"cameraPosition,omitempty"`
+	CameraRotation  *Vector3    `json:"cameraRotation,omitempty"`
+	CameraAttached  *bool       `json:"cameraAttached,omitempty"`
+	CameraMoveSpeed *float64    `json:"cameraMoveSpeed,omitempty"`
+	CameraLookSpeed *float64    `json:"cameraLookSpeed,omitempty"`
+	FieldOfView     *float64    `json:"fieldOfView,omitempty"`
+	NearClip        *float64    `json:"nearClip,omitempty"`
+	FarClip         *float64    `json:"farClip,omitempty"`
+	SelectionName   *string     `json:"selectionName,omitempty"`
+	SelectionOffset *Vector3    `json:"selectionOffset,omitempty"`
+
+	FogOfWar            *bool `json:"fogOfWar,omitempty"`
+	OutlineSelect       *bool `json:"outlineSelect,omitempty"`
+	OutlineHover        *bool `json:"outlineHover,omitempty"`
+	FloatingText        *bool `json:"floatingText,omitempty"`
+	Characters          *bool `json:"characters,omitempty"`
+	Environment         *bool `json:"environment,omitempty"`
+	Particles           *bool `json:"particles,omitempty"`
+	Banners             *bool `json:"banners,omitempty"`
+	DepthOfFieldEnabled *bool `json:"depthOfFieldEnabled,omitempty"`
+
+	HealthBarChampions  *bool `json:"healthBarChampions,omitempty"`
+	HealthBarMinions    *bool `json:"healthBarMinions,omitempty"`
+	HealthBarPets       *bool `json:"healthBarPets,omitempty"`
+	HealthBarStructures *bool `json:"healthBarStructures,omitempty"`
+	HealthBarWards      *bool `json:"healthBarWards,omitempty"`
+
+	InterfaceAll           *bool `json:"interfaceAll,omitempty"`
+	InterfaceAnnounce      *bool `json:"interfaceAnnounce,omitempty"`
+	InterfaceChat          *bool `json:"interfaceChat,omitempty"`
+	InterfaceFrames        *bool `json:"interfaceFrames,omitempty"`
+	InterfaceKillCallouts  *bool `json:"interfaceKillCallouts,omitempty"`
+	InterfaceMinimap       *bool `json:"interfaceMinimap,omitempty"`
+	InterfaceNeutralTimers *bool `json:"interfaceNeutralTimers,omitempty"`
+	InterfaceReplay        *bool `json:"interfaceReplay,omitempty"`
+	InterfaceScore         *bool `json:"interfaceScore,omitempty"`
+	InterfaceScoreboard    *bool `json:"interfaceScoreboard,omitempty"`
+	InterfaceTarget        *bool `json:"interfaceTarget,omitempty"`
+	InterfaceTimeline      *bool `json:"interfaceTimeline,omitempty"`
+}
+
+// Recording is the state of the game's built-in video recorder
+type Recording struct {
+	Recording        bool    `json:"recording"`
+	Path             string  `json:"path"`
+	Codec            string  `json:"codec"`
+	CurrentTime      float64 `json:"currentTime"`
+	StartTime        float64 `json:"startTime"`
+	EndTime          float64 `json:"endTime"`
+	Width            int     `json:"width"`
+	Height           int     `json:"height"`
+	FramesPerSecond  int     `json:"framesPerSecond"`
+	EnforceFrameRate bool    `json:"enforceFrameRate"`
+	LossyRecording   bool    `json:"lossyRecording"`
+	ReplaySpeed      float64 `json:"replaySpeed"`
+}
+
+// RecordingSettings configures a recording started with StartRecording.
+// Zero fields use the game's defaults.
+type RecordingSettings struct {
+	Path             string  `json:"path,omitempty"`
+	Codec            string  `json:"codec,omitempty"` // e.g. "webm"
+	StartTime        float64 `json:"startTime,omitempty"`
+	EndTime          float64 `json:"endTime,omitempty"`
+	Width            int     `json:"width,omitempty"`
+	Height           int     `json:"height,omitempty"`
+	FramesPerSecond  int     `json:"framesPerSecond,omitempty"`
+	EnforceFrameRate bool    `json:"enforceFrameRate,omitempty"`
+	LossyRecording   bool    `json:"lossyRecording,omitempty"`
+	ReplaySpeed      float64 `json:"replaySpeed,omitempty"`
+}
+
+// ReplayClient controls a replay or spectated game. The game only serves
+// the replay API when EnableReplayApi=1 is set in the [General] section of
+// its game.cfg; otherwise requests fail with a 404 *lcu.APIError.
+type ReplayClient struct {
+	client *Client
+}
+
+// NewReplayClient creates a new replay client with the given configuration.
+// Like NewClient, no connection is made until the first request.
+func NewReplayClient(config *Config) *ReplayClient {
+	return &ReplayClient{client: NewClient(config)}
+}
+
+// GetGame returns the game process the replay is played in
+func (r *ReplayClient) GetGame() (*ReplayGame, error) {
+	return r.GetGameContext(context.Background())
+}
+
+// GetGameContext is like GetGame but uses the provided context
+func (r *ReplayClient) GetGameContext(ctx context.Context) (*ReplayGame, error) {
+	var game ReplayGame
+	if err := r.client.GetContext(ctx, "/replay/game", &game); err != nil {
+		return nil, fmt.Errorf("failed to get replay game: %w", err)
+	}
+
+	return &game, nil
+}
+
+// GetPlayback returns the playback state
+func (r *ReplayClient) GetPlayback() (*Playback, error) {
+	return r.GetPlaybackContext(context.Background())
+}
+
+// GetPlaybackContext is like GetPlayback but uses the provided context
+func (r *ReplayClient) GetPlaybackContext(ctx context.Context) (*Playback, error) {
+	var playback Playback
+	if err := r.client.GetContext(ctx, "/replay/playback", &playback); err != nil {
+		return nil, fmt.Errorf("failed to get playback: %w", err)
+	}
+
+	return &playback, nil
+}
+
+// SetPlayback changes the playback state and returns the new state
+func (r *ReplayClient) SetPlayback(update PlaybackUpdate) (*Playback, error) {
+	return r.SetPlaybackContext(context.Background(), update)
+}
+
+// SetPlaybackContext is like SetPlayback but uses the provided context
+func (r *ReplayClient) SetPlaybackContext(ctx context.Context, update PlaybackUpdate) (*Playback, error) {
+	var playback Playback
+	if err := r.client.PostContext(ctx, "/replay/playback", update, &playback); err != nil {
+		return nil, fmt.Errorf("failed to set playback: %w", err)
+	}
+
+	return &playback, nil
+}
+
+// Seek jumps to t seconds into the game. The replay keeps seeking for a
+// while afterwards; use WaitUntilLoaded to wait for it to finish.
+func (r *ReplayClient) Seek(t float64) error {
+	return r.SeekContext(context.Background(), t)
+}
+
+// SeekContext is like Seek but uses the provided context
+func (r *ReplayClient) SeekContext(ctx context.Context, t float64) error {
+	_, err := r.SetPlaybackContext(ctx, PlaybackUpdate{Time: &t})
+	return err
+}
+
+// SetSpeed sets the playback speed, 1 being real time
+func (r *ReplayClient) SetSpeed(speed float64) error {
+	return r.SetSpeedContext(context.Background(), speed)
+}
+
+// SetSpeedContext is like SetSpeed but uses the provided context
+func (r *ReplayClient) SetSpeedContext(ctx context.Context, speed float64) error {
+	_, err := r.SetPlaybackContext(ctx, PlaybackUpdate{Speed: &speed})
+	return err
+}
+
+// Pause pauses the playback
+func (r *ReplayClient) Pause() error {
+	return r.PauseContext(context.Background())
+}
+
+// PauseContext is like Pause but uses the provided context
+func (r *ReplayClient) PauseContext(ctx context.Context) error {
+	paused := true
+	_, err := r.SetPlaybackContext(ctx, PlaybackUpdate{Paused: &paused})
+	return err
+}
+
+// Resume resumes the playback
+func (r *ReplayClient) Resume() error {
+	return r.ResumeContext(context.Background())
+}
+
+// ResumeContext is like Resume but uses the provided context
+func (r *ReplayClient) ResumeContext(ctx context.Context) error {
+	paused := false
+	_, err := r.SetPlaybackContext(ctx, PlaybackUpdate{Paused: &paused})
+	return err
+}
+
+// GetRender returns the camera and interface settings
+func (r *ReplayClient) GetRender() (*Render, error) {
+	return r.GetRenderContext(context.Background())
+}
+
+// GetRenderContext is like GetRender but uses the provided context
+func (r *ReplayClient) GetRenderContext(ctx context.Context) (*Render, error) {
+	var render Render
+	if err := r.client.GetContext(ctx, "/replay/render", &render); err != nil {
+		return nil, fmt.Errorf("failed to get render settings: %w", err)
+	}
+
+	return &render, nil
+}
+
+// SetRender changes the camera and interface settings and returns the new settings
+func (r *ReplayClient) SetRender(update RenderUpdate) (*Render, error) {
+	return r.SetRenderContext(context.Background(), update)
+}
+
+// SetRenderContext is like SetRender but uses the provided context
+func (r *ReplayClient) SetRenderContext(ctx context.Context, update RenderUpdate) (*Render, error) {
+	var render Render
+	if err := r.client.PostContext(ctx, "/replay/render", update, &render); err != nil {
+		return nil, fmt.Errorf("failed to set render settings: %w", err)
+	}
+
+	return &render, nil
+}
+
+// SetCamera switches the camera mode
+func (r *ReplayClient) SetCamera(mode CameraMode) error {
+	return r.SetCameraContext(context.Background(), mode)
+}
+
+// SetCameraContext is like SetCamera but uses the provided context
+func (r *ReplayClient) SetCameraContext(ctx context.Context, mode CameraMode) error {
+	_, err := r.SetRenderContext(ctx, RenderUpdate{CameraMode: &mode})
+	return err
+}
+
+// FollowPlayer attaches the camera to a player, identified by their name in the game
+func (r *ReplayClient) FollowPlayer(name string) error {
+	return r.FollowPlayerContext(context.Background(), name)
+}
+
+// FollowPlayerContext is like FollowPlayer but uses the provided context
+func (r *ReplayClient) FollowPlayerContext(ctx context.Context, name string) error {
+	attached := true
+	_, err := r.SetRenderContext(ctx, RenderUpdate{SelectionName: &name, CameraAttached: &attached})
+	return err
+}
+
+// ShowInterface shows or hides the whole interface
+func (r *ReplayClient) ShowInterface(show bool) error {
+	return r.ShowInterfaceContext(context.Background(), show)
+}
+
+// ShowInterfaceContext is like ShowInterface but uses the provided context
+func (r *ReplayClient) ShowInterfaceContext(ctx context.Context, show bool) error {
+	_, err := r.SetRenderContext(ctx, RenderUpdate{InterfaceAll: &show})
+	return err
+}
+
+// GetRecording returns the state of the recorder
+func (r *ReplayClient) GetRecording() (*Recording, error) {
+	return r.GetRecordingContext(context.Background())
+}
+
+// GetRecordingContext is like GetRecording but uses the provided context
+func (r *ReplayClient) GetRecordingContext(ctx context.Context) (*Recording, error) {
+	var recording Recording
+	if err := r.client.GetContext(ctx, "/replay/recording", &recording); err != nil {
+		return nil, fmt.Errorf("failed to get recording: %w", err)
+	}
+
+	return &recording, nil
+}
+
+// StartRecording starts recording the replay to a video file
+func (r *ReplayClient) StartRecording(settings RecordingSettings) (*Recording, error) {
+	return r.StartRecordingContext(context.Background(), settings)
+}
+
+// StartRecordingContext is like StartRecording but uses the provided context
+func (r *ReplayClient) StartRecordingContext(ctx context.Context, settings RecordingSettings) (*Recording, error) {
+	body := struct {
+		Recording bool `json:"recording"`
+		RecordingSettings
+	}{true, settings}
+
+	var recording Recording
+	if err := r.client.PostContext(ctx, "/replay/recording", body, &recording); err != nil {
+		return nil, fmt.Errorf("failed to start recording: %w", err)
+	}
+
+	return &recording, nil
+}
+
+// StopRecording stops the recorder
+func (r *ReplayClient) StopRecording() error {
+	return r.StopRecordingContext(context.Background())
+}
+
+// StopRecordingContext is like StopRecording but uses the provided context
+func (r *ReplayClient) StopRecordingContext(ctx context.Context) error {
+	body := map[string]bool{"recording": false}
+	if err := r.client.PostContext(ctx, "/replay/recording", body, nil); err != nil {
+		return fmt.Errorf("failed to stop recording: %w", err)
+	}
+
+	return nil
+}
+
+// WaitUntilLoaded polls the playback every PollInterval until the replay is
+// loaded and not seeking, which also makes it usable after Seek. Errors while
+// the game is still starting are retried.
+func (r *ReplayClient) WaitUntilLoaded() (*Playback, error) {
+	return r.WaitUntilLoadedContext(context.Background())
+}
+
+// WaitUntilLoadedContext is like WaitUntilLoaded but stops waiting when ctx is done
+func (r *ReplayClient) WaitUntilLoadedContext(ctx context.Context) (*Playback, error) {
+	return r.waitFor(ctx, func(p *Playback) bool {
+		return p.Length > 0 && !p.Seeking
+	})
+}
+
+// WaitUntilTime polls the playback every PollInterval until it reaches t seconds into the game
+func (r *ReplayClient) WaitUntilTime(t float64) (*Playback, error) {
+	return r.WaitUntilTimeContext(context.Background(), t)
+}
+
+// WaitUntilTimeContext is like WaitUntilTime but stops waiting when ctx is done
+func (r *ReplayClient) WaitUntilTimeContext(ctx context.Context, t float64) (*Playback, error) {
+	return r.waitFor(ctx, func(p *Playback) bool {
+		return p.Time >= t && !p.Seeking
+	})
+}
+
+func (r *ReplayClient) waitFor(ctx context.Context, done func(*Playback) bool) (*Playback, error) {
+	interval := r.client.config.PollInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		playback, err := r.GetPlaybackContext(ctx)
+		if err == nil && done(playback) {
+			return playback, nil
+		}
+		if err != nil && r.client.config.Debug {
+			r.client.logger.Debug("liveclient", "Replay not ready: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("stopped waiting for replay: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
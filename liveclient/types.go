@@ -0,0 +1,226 @@
+package liveclient
+
+// Team is the side of the map a player is on
+type Team string
+
+const (
+	TeamOrder Team = "ORDER" // Blue side
+	TeamChaos Team = "CHAOS" // Red side
+)
+
+// EventName is the type of a game event
+type EventName string
+
+const (
+	EventGameStart           EventName = "GameStart"
+	EventMinionsSpawning     EventName = "MinionsSpawning"
+	EventFirstBrick          EventName = "FirstBrick"
+	EventFirstBlood          EventName = "FirstBlood"
+	EventTurretKilled        EventName = "TurretKilled"
+	EventInhibKilled         EventName = "InhibKilled"
+	EventInhibRespawningSoon EventName = "InhibRespawningSoon"
+	EventInhibRespawned      EventName = "InhibRespawned"
+	EventChampionKill        EventName = "ChampionKill"
+	EventMultikill           EventName = "Multikill"
+	EventAce                 EventName = "Ace"
+	EventDragonKill          EventName = "DragonKill"
+	EventHeraldKill          EventName = "HeraldKill"
+	EventHordeKill           EventName = "HordeKill"
+	EventBaronKill           EventName = "BaronKill"
+	EventGameEnd             EventName = "GameEnd"
+)
+
+// AllGameData is the response of /liveclientdata/allgamedata
+type AllGameData struct {
+	ActivePlayer ActivePlayer `json:"activePlayer"`
+	AllPlayers   []Player     `json:"allPlayers"`
+	Events       EventData    `json:"events"`
+	GameData     GameStats    `json:"gameData"`
+}
+
+// ActivePlayer is the player the game client belongs to
+type ActivePlayer struct {
+	Abilities          Abilities     `json:"abilities"`
+	ChampionStats      ChampionStats `json:"championStats"`
+	CurrentGold        float64       `json:"currentGold"`
+	FullRunes          FullRunes     `json:"fullRunes"`
+	Level              int           `json:"level"`
+	RiotID             string        `json:"riotId"`
+	RiotIDGameName     string        `json:"riotIdGameName"`
+	RiotIDTagLine      string        `json:"riotIdTagLine"`
+	SummonerName       string        `json:"summonerName"`
+	TeamRelativeColors bool          `json:"teamRelativeColors"`
+}
+
+// Abilities are the abilities of the active player's champion
+type Abilities struct {
+	Passive Ability `json:"Passive"`
+	Q       Ability `json:"Q"`
+	W       Ability `json:"W"`
+	E       Ability `json:"E"`
+	R       Ability `json:"R"`
+}
+
+// Ability is a single champion ability
+type Ability struct {
+	AbilityLevel   int    `json:"abilityLevel"`
+	DisplayName    string `json:"displayName"`
+	ID             string `json:"id"`
+	RawDescription string `json:"rawDescription"`
+	RawDisplayName string `json:"rawDisplayName"`
+}
+
+// ChampionStats are the current stats of the active player's champion
+type ChampionStats struct {
+	AbilityHaste                 float64 `json:"abilityHaste"`
+	AbilityPower                 float64 `json:"abilityPower"`
+	Armor                        float64 `json:"armor"`
+	ArmorPenetrationFlat         float64 `json:"armorPenetrationFlat"`
+	ArmorPenetrationPercent      float64 `json:"armorPenetrationPercent"`
+	AttackDamage                 float64 `json:"attackDamage"`
+	AttackRange                  float64 `json:"attackRange"`
+	AttackSpeed                  float64 `json:"attackSpeed"`
+	BonusArmorPenetrationPercent float64 `json:"bonusArmorPenetrationPercent"`
+	BonusMagicPenetrationPercent float64 `json:"bonusMagicPenetrationPercent"`
+	CritChance                   float64 `json:"critChance"`
+	CritDamage                   float64 `json:"critDamage"`
+	CurrentHealth                float64 `json:"currentHealth"`
+	HealShieldPower              float64 `json:"healShieldPower"`
+	HealthRegenRate              float64 `json:"healthRegenRate"`
+	LifeSteal                    float64 `json:"lifeSteal"`
+	MagicLethality               float64 `json:"magicLethality"`
+	MagicPenetrationFlat         float64 `json:"magicPenetrationFlat"`
+	MagicPenetrationPercent      float64 `json:"magicPenetrationPercent"`
+	MagicResist                  float64 `json:"magicResist"`
+	MaxHealth                    float64 `json:"maxHealth"`
+	MoveSpeed                    float64 `json:"moveSpeed"`
+	Omnivamp                     float64 `json:"omnivamp"`
+	PhysicalLethality            float64 `json:"physicalLethality"`
+	PhysicalVamp                 float64 `json:"physicalVamp"`
+	ResourceMax                  float64 `json:"resourceMax"`
+	ResourceRegenRate            float64 `json:"resourceRegenRate"`
+	ResourceType                 string  `json:"resourceType"`
+	ResourceValue                float64 `json:"resourceValue"`
+	SpellVamp                    float64 `json:"spellVamp"`
+	Tenacity                     float64 `json:"tenacity"`
+}
+
+// FullRunes are all runes of the active player
+type FullRunes struct {
+	GeneralRunes      []Rune `json:"generalRunes"`
+	Keystone          Rune   `json:"keystone"`
+	PrimaryRuneTree   Rune   `json:"primaryRuneTree"`
+	SecondaryRuneTree Rune   `json:"secondaryRuneTree"`
+	StatRunes         []struct {
+		ID             int    `json:"id"`
+		RawDescription string `json:"rawDescription"`
+	} `json:"statRunes"`
+}
+
+// Rune is a rune or rune tree
+type Rune struct {
+	DisplayName    string `json:"displayName"`
+	ID             int    `json:"id"`
+	RawDescription string `json:"rawDescription"`
+	RawDisplayName string `json:"rawDisplayName"`
+}
+
+// Player is a player in the game
+type Player struct {
+	ChampionName    string         `json:"championName"`
+	IsBot           bool           `json:"isBot"`
+	IsDead          bool           `json:"isDead"`
+	Items           []Item         `json:"items"`
+	Level           int            `json:"level"`
+	Position        string         `json:"position"`
+	RawChampionName string         `json:"rawChampionName"`
+	RespawnTimer    float64        `json:"respawnTimer"`
+	RiotID          string         `json:"riotId"`
+	RiotIDGameName  string         `json:"riotIdGameName"`
+	RiotIDTagLine   string         `json:"riotIdTagLine"`
+	Runes           PlayerRunes    `json:"runes"`
+	Scores          Scores         `json:"scores"`
+	SkinID          int            `json:"skinID"`
+	SummonerName    string         `json:"summonerName"`
+	SummonerSpells  SummonerSpells `json:"summonerSpells"`
+	Team            Team           `json:"team"`
+}
+
+// Item is an item in a player's inventory
+type Item struct {
+	CanUse         bool   `json:"canUse"`
+	Consumable     bool   `json:"consumable"`
+	Count          int    `json:"count"`
+	DisplayName    string `json:"displayName"`
+	ItemID         int    `json:"itemID"`
+	Price          int    `json:"price"`
+	RawDescription string `json:"rawDescription"`
+	RawDisplayName string `json:"rawDisplayName"`
+	Slot           int    `json:"slot"`
+}
+
+// PlayerRunes are the runes of a player visible to everyone
+type PlayerRunes struct {
+	Keystone          Rune `json:"keystone"`
+	PrimaryRuneTree   Rune `json:"primaryRuneTree"`
+	SecondaryRuneTree Rune `json:"secondaryRuneTree"`
+}
+
+// Scores are a player's scoreboard entries
+type Scores struct {
+	Assists    int     `json:"assists"`
+	CreepScore int     `json:"creepScore"`
+	Deaths     int     `json:"deaths"`
+	Kills      int     `json:"kills"`
+	WardScore  float64 `json:"wardScore"`
+}
+
+// SummonerSpells are a player's summoner spells
+type SummonerSpells struct {
+	SummonerSpellOne SummonerSpell `json:"summonerSpellOne"`
+	SummonerSpellTwo SummonerSpell `json:"summonerSpellTwo"`
+}
+
+// SummonerSpell is a single summoner spell
+type SummonerSpell struct {
+	DisplayName    string `json:"displayName"`
+	RawDescription string `json:"rawDescription"`
+	RawDisplayName string `json:"rawDisplayName"`
+}
+
+// EventData is the response of /liveclientdata/eventdata
+type EventData struct {
+	Events []GameEvent `json:"Events"`
+}
+
+// GameEvent is something that happened in the game. Which of the optional
+// fields are set depends on EventName.
+type GameEvent struct {
+	EventID   int       `json:"EventID"`
+	EventName EventName `json:"EventName"`
+	EventTime float64   `json:"EventTime"` // Seconds since the game started
+
+	KillerName          string   `json:"KillerName,omitempty"`
+	VictimName          string   `json:"VictimName,omitempty"`
+	Assisters           []string `json:"Assisters,omitempty"`
+	KillStreak          int      `json:"KillStreak,omitempty"`          // Multikill
+	Acer                string   `json:"Acer,omitempty"`                // Ace
+	AcingTeam           Team     `json:"AcingTeam,omitempty"`           // Ace
+	Recipient           string   `json:"Recipient,omitempty"`           // FirstBlood
+	TurretKilled        string   `json:"TurretKilled,omitempty"`        // TurretKilled
+	InhibKilled         string   `json:"InhibKilled,omitempty"`         // InhibKilled
+	InhibRespawned      string   `json:"InhibRespawned,omitempty"`      // InhibRespawned
+	InhibRespawningSoon string   `json:"InhibRespawningSoon,omitempty"` // InhibRespawningSoon
+	DragonType          string   `json:"DragonType,omitempty"`          // DragonKill, e.g. "Fire" or "Elder"
+	Stolen              string   `json:"Stolen,omitempty"`              // DragonKill, HeraldKill and BaronKill, "True" or "False"
+	Result              string   `json:"Result,omitempty"`              // GameEnd, "Win" or "Lose"
+}
+
+// GameStats is the response of /liveclientdata/gamestats
+type GameStats struct {
+	GameMode   string  `json:"gameMode"`
+	GameTime   float64 `json:"gameTime"` // Seconds since the game started
+	MapName    string  `json:"mapName"`
+	MapNumber  int     `json:"mapNumber"`
+	MapTerrain string  `json:"mapTerrain"`
+}
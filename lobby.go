@@ -0,0 +1,276 @@
+package lcu
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// BotDifficulty represents the difficulty of a bot in a custom game
+type BotDifficulty string
+
+const (
+	BotDifficultyIntro  BotDifficulty = "INTRO"
+	BotDifficultyEasy   BotDifficulty = "EASY"
+	BotDifficultyMedium BotDifficulty = "MEDIUM"
+	BotDifficultyHard   BotDifficulty = "HARD"
+)
+
+// Team IDs of the two teams of a custom game
+const (
+	TeamBlue = 100
+	TeamRed  = 200
+)
+
+// CustomGameConfig represents the settings of a custom game lobby.
+// Zero values are replaced by the defaults of a 5v5 Summoner's Rift game.
+type CustomGameConfig struct {
+	Name            string // Lobby name shown in the custom game list
+	Password        string // Lobby password, none if empty
+	GameMode        string // Game mode (default: "CLASSIC")
+	MapID           int    // Map ID (default: 11, Summoner's Rift)
+	TeamSize        int    // Players per team (default: 5)
+	MutatorID       int    // Pick type, 1 blind pick, 2 draft, 4 all random, 6 tournament draft (default: 1)
+	SpectatorPolicy string // Who may spectate: AllAllowed, LobbyAllowed, FriendsAllowed or NotAllowed (default: "AllAllowed")
+}
+
+// CreateLobby creates a lobby for a matchmade queue, such as QueueRankedSolo
+func (c *Client) CreateLobby(queueID int) (*Lobby, error) {
+	return c.CreateLobbyContext(context.Background(), queueID)
+}
+
+// CreateLobbyContext is like CreateLobby but uses the provided context
+func (c *Client) CreateLobbyContext(ctx context.Context, queueID int) (*Lobby, error) {
+	body := map[string]int{"queueId": queueID}
+	lobby, err := PostJSONContext[map[string]int, Lobby](ctx, c, "/lol-lobby/v2/lobby", body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create lobby: %w", err)
+	}
+
+	return lobby, nil
+}
+
+// CreateCustomLobby creates a custom game lobby
+//
+// Parameters:
+//   - config: The custom game settings, zero values use the defaults
+//
+// Returns:
+//   - *Lobby: The created lobby
+//   - error: Any error that occurred while creating the lobby
+func (c *Client) CreateCustomLobby(config CustomGameConfig) (*Lobby, error) {
+	return c.CreateCustomLobbyContext(context.Background(), config)
+}
+
+// CreateCustomLobbyContext is like CreateCustomLobby but uses the provided context
+func (c *Client) CreateCustomLobbyContext(ctx context.Context, config CustomGameConfig) (*Lobby, error) {
+	if config.GameMode == "" {
+		config.GameMode = "CLASSIC"
+	}
+	if config.MapID == 0 {
+		config.MapID = 11
+	}
+	if config.TeamSize == 0 {
+		config.TeamSize = 5
+	}
+	if config.MutatorID == 0 {
+		config.MutatorID = 1
+	}
+	if config.SpectatorPolicy == "" {
+		config.SpectatorPolicy = "AllAllowed"
+	}
+
+	body := map[string]interface{}{
+		"isCustom": true,
+		"customGameLobby": map[string]interface{}{
+			"lobbyName":     config.Name,
+			"lobbyPassword": config.Password,
+			"configuration": map[string]interface{}{
+				"gameMode":        config.GameMode,
+				"mapId":           config.MapID,
+				"teamSize":        config.TeamSize,
+				"mutators":        map[string]int{"id": config.MutatorID},
+				"spectatorPolicy": config.SpectatorPolicy,
+			},
+		},
+	}
+	lobby, err := PostJSONContext[map[string]interface{}, Lobby](ctx, c, "/lol-lobby/v2/lobby", body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create custom lobby: %w", err)
+	}
+
+	return lobby, nil
+}
+
+// LeaveLobby leaves the current lobby
+func (c *Client) LeaveLobby() error {
+	return c.LeaveLobbyContext(context.Background())
+}
+
+// LeaveLobbyContext is like LeaveLobby but uses the provided context
+func (c *Client) LeaveLobbyContext(ctx context.Context) error {
+	if _, err := DeleteJSONContext[struct{}](ctx, c, "/lol-lobby/v2/lobby"); err != nil {
+		return fmt.Errorf("failed to leave lobby: %w", err)
+	}
+
+	return nil
+}
+
+// SetPositionPreferences sets the first and second position of the local
+// player, using the Position constants such as PositionMiddle
+func (c *Client) SetPositionPreferences(first, second string) (*Lobby, error) {
+	return c.SetPositionPreferencesContext(context.Background(), first, second)
+}
+
+// SetPositionPreferencesContext is like SetPositionPreferences but uses the provided context
+func (c *Client) SetPositionPreferencesContext(ctx context.Context, first, second string) (*Lobby, error) {
+	body := map[string]string{
+		"firstPreference":  first,
+		"secondPreference": second,
+	}
+	endpoint := "/lol-lobby/v2/lobby/members/localMember/position-preferences"
+	if _, err := PutJSONContext[map[string]string, struct{}](ctx, c, endpoint, body); err != nil {
+		return nil, fmt.Errorf("failed to set position preferences: %w", err)
+	}
+
+	return c.GetLobbyContext(ctx)
+}
+
+// InviteToLobby invites summoners to the current lobby
+func (c *Client) InviteToLobby(summonerIDs ...int64) (*Lobby, error) {
+	return c.InviteToLobbyContext(context.Background(), summonerIDs...)
+}
+
+// InviteToLobbyContext is like InviteToLobby but uses the provided context
+func (c *Client) InviteToLobbyContext(ctx context.Context, summonerIDs ...int64) (*Lobby, error) {
+	invitations := make([]map[string]int64, 0, len(summonerIDs))
+	for _, id := range summonerIDs {
+		invitations = append(invitations, map[string]int64{"toSummonerId": id})
+	}
+	if _, err := PostJSONContext[[]map[string]int64, struct{}](ctx, c, "/lol-lobby/v2/lobby/invitations", invitations); err != nil {
+		return nil, fmt.Errorf("failed to invite to lobby: %w", err)
+	}
+
+	return c.GetLobbyContext(ctx)
+}
+
+// KickFromLobby removes a member from the current lobby. The local player must be the leader.
+func (c *Client) KickFromLobby(summonerID int64) (*Lobby, error) {
+	return c.KickFromLobbyContext(context.Background(), summonerID)
+}
+
+// KickFromLobbyContext is like KickFromLobby but uses the provided context
+func (c *Client) KickFromLobbyContext(ctx context.Context, summonerID int64) (*Lobby, error) {
+	if err := c.postLobbyMember(ctx, summonerID, "kick"); err != nil {
+		return nil, fmt.Errorf("failed to kick from lobby: %w", err)
+	}
+
+	return c.GetLobbyContext(ctx)
+}
+
+// PromoteToLeader makes a member the leader of the current lobby. The local player must be the leader.
+func (c *Client) PromoteToLeader(summonerID int64) (*Lobby, error) {
+	return c.PromoteToLeaderContext(context.Background(), summonerID)
+}
+
+// PromoteToLeaderContext is like PromoteToLeader but uses the provided context
+func (c *Client) PromoteToLeaderContext(ctx context.Context, summonerID int64) (*Lobby, error) {
+	if err := c.postLobbyMember(ctx, summonerID, "promote"); err != nil {
+		return nil, fmt.Errorf("failed to promote to leader: %w", err)
+	}
+
+	return c.GetLobbyContext(ctx)
+}
+
+// postLobbyMember performs an action without a body on a lobby member
+func (c *Client) postLobbyMember(ctx context.Context, summonerID int64, action string) error {
+	endpoint := "/lol-lobby/v2/lobby/members/" + strconv.FormatInt(summonerID, 10) + "/" + action
+	_, err := DoJSON[*struct{}, struct{}](ctx, c, "POST", endpoint, nil)
+	return err
+}
+
+// StartMatchmaking starts searching for a match with the current lobby
+func (c *Client) StartMatchmaking() (*Lobby, error) {
+	return c.StartMatchmakingContext(context.Background())
+}
+
+// StartMatchmakingContext is like StartMatchmaking but uses the provided context
+func (c *Client) StartMatchmakingContext(ctx context.Context) (*Lobby, error) {
+	if _, err := DoJSON[*struct{}, struct{}](ctx, c, "POST", "/lol-lobby/v2/lobby/matchmaking/search", nil); err != nil {
+		return nil, fmt.Errorf("failed to start matchmaking: %w", err)
+	}
+
+	return c.GetLobbyContext(ctx)
+}
+
+// StopMatchmaking stops searching for a match
+func (c *Client) StopMatchmaking() (*Lobby, error) {
+	return c.StopMatchmakingContext(context.Background())
+}
+
+// StopMatchmakingContext is like StopMatchmaking but uses the provided context
+func (c *Client) StopMatchmakingContext(ctx context.Context) (*Lobby, error) {
+	if _, err := DeleteJSONContext[struct{}](ctx, c, "/lol-lobby/v2/lobby/matchmaking/search"); err != nil {
+		return nil, fmt.Errorf("failed to stop matchmaking: %w", err)
+	}
+
+	return c.GetLobbyContext(ctx)
+}
+
+// AddBot adds a bot to a team of the current custom game lobby
+//
+// Parameters:
+//   - championID: The champion the bot plays
+//   - difficulty: The bot difficulty (e.g., BotDifficultyMedium)
+//   - teamID: The team to add the bot to, TeamBlue or TeamRed
+//
+// Returns:
+//   - *Lobby: The lobby after adding the bot
+//   - error: Any error that occurred while adding the bot
+func (c *Client) AddBot(championID int, difficulty BotDifficulty, teamID int) (*Lobby, error) {
+	return c.AddBotContext(context.Background(), championID, difficulty, teamID)
+}
+
+// AddBotContext is like AddBot but uses the provided context
+func (c *Client) AddBotContext(ctx context.Context, championID int, difficulty BotDifficulty, teamID int) (*Lobby, error) {
+	body := map[string]interface{}{
+		"championId":    championID,
+		"botDifficulty": difficulty,
+		"teamId":        strconv.Itoa(teamID),
+	}
+	if _, err := PostJSONContext[map[string]interface{}, struct{}](ctx, c, "/lol-lobby/v1/lobby/custom/bots", body); err != nil {
+		return nil, fmt.Errorf("failed to add bot: %w", err)
+	}
+
+	return c.GetLobbyContext(ctx)
+}
+
+// RemoveBot removes a bot from the current custom game lobby. botName is
+// the SummonerInternalName of the bot's LobbyMember.
+func (c *Client) RemoveBot(botName string) (*Lobby, error) {
+	return c.RemoveBotContext(context.Background(), botName)
+}
+
+// RemoveBotContext is like RemoveBot but uses the provided context
+func (c *Client) RemoveBotContext(ctx context.Context, botName string) (*Lobby, error) {
+	if _, err := DeleteJSONContext[struct{}](ctx, c, "/lol-lobby/v1/lobby/custom/bots/"+url.PathEscape(botName)); err != nil {
+		return nil, fmt.Errorf("failed to remove bot: %w", err)
+	}
+
+	return c.GetLobbyContext(ctx)
+}
+
+// StartCustomGame starts champion select for the current custom game lobby
+func (c *Client) StartCustomGame() (*Lobby, error) {
+	return c.StartCustomGameContext(context.Background())
+}
+
+// StartCustomGameContext is like StartCustomGame but uses the provided context
+func (c *Client) StartCustomGameContext(ctx context.Context) (*Lobby, error) {
+	if _, err := DoJSON[*struct{}, struct{}](ctx, c, "POST", "/lol-lobby/v1/lobby/custom/start-champ-select", nil); err != nil {
+		return nil, fmt.Errorf("failed to start custom game: %w", err)
+	}
+
+	return c.GetLobbyContext(ctx)
+}
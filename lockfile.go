@@ -0,0 +1,197 @@
+package lcu
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LockfileEventType represents a change to the LCU lockfile
+type LockfileEventType string
+
+const (
+	LockfileCreated LockfileEventType = "Created"
+	LockfileChanged LockfileEventType = "Changed"
+	LockfileDeleted LockfileEventType = "Deleted"
+)
+
+// LockfileEvent is published by a LockfileWatcher when the lockfile appears,
+// changes or disappears. Credentials is nil for LockfileDeleted.
+type LockfileEvent struct {
+	Type        LockfileEventType
+	Path        string
+	Credentials *Credentials
+}
+
+// LockfileWatcher monitors the LCU lockfile and publishes an event whenever
+// the League client starts, restarts or exits. It polls the same locations
+// that NewClient searches, every Config.PollInterval.
+type LockfileWatcher struct {
+	config *Config
+
+	// State of the last poll
+	path string
+	data []byte
+}
+
+// NewLockfileWatcher creates a watcher for the lockfile locations derived
+// from config. If no configuration is provided, it uses the default configuration.
+func NewLockfileWatcher(config *Config) *LockfileWatcher {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	return &LockfileWatcher{config: config}
+}
+
+// Watch starts polling and returns a channel of lockfile events. If the
+// lockfile already exists, a LockfileCreated event is sent first. The
+// channel is closed when ctx is cancelled.
+func (w *LockfileWatcher) Watch(ctx context.Context) <-chan LockfileEvent {
+	events := make(chan LockfileEvent, 1)
+
+	go func() {
+		defer close(events)
+
+		interval := w.config.PollInterval
+		if interval <= 0 {
+			interval = 2 * time.Second
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if event, ok := w.poll(); ok {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events
+}
+
+// poll checks the lockfile locations once and reports a change, if any
+func (w *LockfileWatcher) poll() (LockfileEvent, bool) {
+	path, data, creds := w.find()
+
+	switch {
+	case creds == nil && w.data == nil:
+		return LockfileEvent{}, false
+	case creds == nil:
+		event := LockfileEvent{Type: LockfileDeleted, Path: w.path}
+		w.path, w.data = "", nil
+		if w.config.Debug {
+			w.config.Logger.Debug("lockfile", "Lockfile deleted: %s", event.Path)
+		}
+		return event, true
+	case w.data == nil:
+		w.path, w.data = path, data
+		if w.config.Debug {
+			w.config.Logger.Debug("lockfile", "Lockfile created: %s", path)
+		}
+		return LockfileEvent{Type: LockfileCreated, Path: path, Credentials: creds}, true
+	case path != w.path || !bytes.Equal(data, w.data):
+		w.path, w.data = path, data
+		if w.config.Debug {
+			w.config.Logger.Debug("lockfile", "Lockfile changed: %s", path)
+		}
+		return LockfileEvent{Type: LockfileChanged, Path: path, Credentials: creds}, true
+	}
+
+	return LockfileEvent{}, false
+}
+
+// find returns the first valid lockfile among the possible locations
+func (w *LockfileWatcher) find() (string, []byte, *Credentials) {
+	paths, err := lockfilePaths(w.config)
+	if err != nil {
+		return "", nil, nil
+	}
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		creds, err := parseLockfile(data)
+		if err != nil {
+			continue // Possibly caught mid-write, retry on the next poll
+		}
+		return path, data, creds
+	}
+
+	return "", nil, nil
+}
+
+// lockfilePaths returns the locations where the LCU lockfile may be found,
+// starting with Config.LeaguePath if set.
+func lockfilePaths(config *Config) ([]string, error) {
+	var possiblePaths []string
+
+	// If a custom path is provided, use it first
+	if config.LeaguePath != "" {
+		possiblePaths = append(possiblePaths, filepath.Join(config.LeaguePath, "lockfile"))
+	}
+
+	// Add platform-specific default paths
+	switch runtime.GOOS {
+	case "windows":
+		// Try common drive letters
+		for _, drive := range []string{"C", "D", "E", "F", "G"} {
+			possiblePaths = append(possiblePaths, filepath.Join(drive+":", "Riot Games", "League of Legends", "lockfile"))
+		}
+	case "darwin":
+		possiblePaths = append(possiblePaths, "/Applications/League of Legends.app/Contents/LoL/lockfile")
+	case "linux":
+		// Check if we're in WSL2 by looking for the Windows lockfile
+		for _, drive := range []string{"c", "d", "e", "f", "g"} {
+			possiblePaths = append(possiblePaths, filepath.Join("/mnt", drive, "Riot Games", "League of Legends", "lockfile"))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported platform: %s", runtime.GOOS)
+	}
+
+	return possiblePaths, nil
+}
+
+// parseLockfile parses the lockfile format "name:pid:port:password:protocol"
+func parseLockfile(data []byte) (*Credentials, error) {
+	parts := strings.Split(strings.TrimSpace(string(data)), ":")
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("invalid lockfile format")
+	}
+
+	pid, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid pid: %w", err)
+	}
+
+	port, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid port: %w", err)
+	}
+
+	return &Credentials{
+		Port:        port,
+		Password:    parts[3],
+		Protocol:    parts[4],
+		ProcessName: parts[0],
+		PID:         pid,
+	}, nil
+}
@@ -0,0 +1,264 @@
+package lcu
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"strings"
+)
+
+// patternIndex matches event URIs against glob and regex patterns.
+//
+// Glob patterns are stored in a trie keyed by path segment, so a URI only
+// walks the branches that can match it. Regex patterns are bucketed by the
+// first path segment of their literal prefix, so only regexes that can match
+// the URI's first segment are evaluated.
+type patternIndex struct {
+	globs   *globNode
+	regexes map[string][]*regexPattern // keyed by first path segment
+	unkeyed []*regexPattern            // regexes without a literal first segment
+	refs    map[string]int
+}
+
+// globNode is a node of the glob trie
+type globNode struct {
+	literal  map[string]*globNode
+	wildcard []*globSegment // segments containing *, ? or [...]
+	exact    []string       // patterns ending at this node
+	rest     []string       // patterns ending in ** at this node
+}
+
+// globSegment is a wildcard segment and the subtree below it
+type globSegment struct {
+	expr string
+	node *globNode
+}
+
+// regexPattern is a compiled regex pattern and its anchored literal prefix
+type regexPattern struct {
+	expr   string
+	re     *regexp.Regexp
+	prefix string
+}
+
+func newPatternIndex() *patternIndex {
+	return &patternIndex{
+		globs:   &globNode{},
+		regexes: make(map[string][]*regexPattern),
+		refs:    make(map[string]int),
+	}
+}
+
+// validatePattern reports whether pattern can be added to the index
+func validatePattern(pattern string) error {
+	if strings.HasPrefix(pattern, "^") {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid regex pattern %q: %w", pattern, err)
+		}
+		return nil
+	}
+
+	segments := splitPath(pattern)
+	for i, segment := range segments {
+		if segment == "**" {
+			if i != len(segments)-1 {
+				return fmt.Errorf("invalid glob pattern %q: ** must be the last segment", pattern)
+			}
+			continue
+		}
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// add registers pattern, or adds a reference if it is already registered
+func (idx *patternIndex) add(pattern string) error {
+	if idx.refs[pattern] > 0 {
+		idx.refs[pattern]++
+		return nil
+	}
+
+	if err := validatePattern(pattern); err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(pattern, "^") {
+		rp := &regexPattern{
+			expr:   pattern,
+			re:     regexp.MustCompile(pattern),
+			prefix: literalPrefix(pattern),
+		}
+		if key, ok := firstSegment(rp.prefix); ok {
+			idx.regexes[key] = append(idx.regexes[key], rp)
+		} else {
+			idx.unkeyed = append(idx.unkeyed, rp)
+		}
+	} else {
+		idx.insertGlob(pattern)
+	}
+
+	idx.refs[pattern] = 1
+	return nil
+}
+
+// remove drops a reference to pattern and unregisters it once unused
+func (idx *patternIndex) remove(pattern string) {
+	if idx.refs[pattern] > 1 {
+		idx.refs[pattern]--
+		return
+	}
+	delete(idx.refs, pattern)
+
+	if strings.HasPrefix(pattern, "^") {
+		isPattern := func(rp *regexPattern) bool { return rp.expr == pattern }
+		key, _ := firstSegment(literalPrefix(pattern))
+		if bucket, ok := idx.regexes[key]; ok {
+			if bucket = slices.DeleteFunc(bucket, isPattern); len(bucket) == 0 {
+				delete(idx.regexes, key)
+			} else {
+				idx.regexes[key] = bucket
+			}
+		}
+		idx.unkeyed = slices.DeleteFunc(idx.unkeyed, isPattern)
+		return
+	}
+
+	node := idx.globs
+	segments := splitPath(pattern)
+	for i, segment := range segments {
+		if segment == "**" && i == len(segments)-1 {
+			node.rest = slices.DeleteFunc(node.rest, func(p string) bool { return p == pattern })
+			return
+		}
+		next := node.child(segment, false)
+		if next == nil {
+			return
+		}
+		node = next
+	}
+	node.exact = slices.DeleteFunc(node.exact, func(p string) bool { return p == pattern })
+}
+
+// match returns every registered pattern that matches uri
+func (idx *patternIndex) match(uri string) []string {
+	var matches []string
+
+	segments := splitPath(uri)
+	idx.globs.match(segments, &matches)
+
+	check := func(rps []*regexPattern) {
+		for _, rp := range rps {
+			if strings.HasPrefix(uri, rp.prefix) && rp.re.MatchString(uri) {
+				matches = append(matches, rp.expr)
+			}
+		}
+	}
+	if len(segments) > 0 {
+		check(idx.regexes[segments[0]])
+	}
+	check(idx.unkeyed)
+
+	return matches
+}
+
+func (idx *patternIndex) insertGlob(pattern string) {
+	node := idx.globs
+	segments := splitPath(pattern)
+	for i, segment := range segments {
+		if segment == "**" && i == len(segments)-1 {
+			node.rest = append(node.rest, pattern)
+			return
+		}
+		node = node.child(segment, true)
+	}
+	node.exact = append(node.exact, pattern)
+}
+
+// child returns the subtree for a pattern segment, creating it if asked
+func (n *globNode) child(segment string, create bool) *globNode {
+	if !strings.ContainsAny(segment, "*?[\\") {
+		if next, ok := n.literal[segment]; ok || !create {
+			return next
+		}
+		if n.literal == nil {
+			n.literal = make(map[string]*globNode)
+		}
+		next := &globNode{}
+		n.literal[segment] = next
+		return next
+	}
+
+	for _, wc := range n.wildcard {
+		if wc.expr == segment {
+			return wc.node
+		}
+	}
+	if !create {
+		return nil
+	}
+	wc := &globSegment{expr: segment, node: &globNode{}}
+	n.wildcard = append(n.wildcard, wc)
+	return wc.node
+}
+
+func (n *globNode) match(segments []string, matches *[]string) {
+	// ** matches the remaining segments, including none
+	*matches = append(*matches, n.rest...)
+
+	if len(segments) == 0 {
+		*matches = append(*matches, n.exact...)
+		return
+	}
+
+	if next, ok := n.literal[segments[0]]; ok {
+		next.match(segments[1:], matches)
+	}
+	for _, wc := range n.wildcard {
+		if ok, _ := path.Match(wc.expr, segments[0]); ok {
+			wc.node.match(segments[1:], matches)
+		}
+	}
+}
+
+// splitPath splits a URI or glob pattern into its path segments
+func splitPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+// firstSegment returns the first complete path segment of a literal prefix
+func firstSegment(prefix string) (string, bool) {
+	rest, ok := strings.CutPrefix(prefix, "/")
+	if !ok {
+		return "", false
+	}
+	segment, _, ok := strings.Cut(rest, "/")
+	return segment, ok && segment != ""
+}
+
+// literalPrefix returns the literal text that every match of an anchored
+// regex must start with
+func literalPrefix(expr string) string {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return ""
+	}
+	re = re.Simplify()
+
+	if re.Op != syntax.OpConcat || len(re.Sub) < 2 || re.Sub[0].Op != syntax.OpBeginText {
+		return ""
+	}
+
+	lit := re.Sub[1]
+	if lit.Op != syntax.OpLiteral || lit.Flags&syntax.FoldCase != 0 {
+		return ""
+	}
+	return string(lit.Rune)
+}
@@ -0,0 +1,122 @@
+package lcu
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// procRoot is the mount point of the proc filesystem on Linux
+const procRoot = "/proc"
+
+// findCredentialsFromProc scans a proc filesystem rooted at root for a
+// LeagueClientUx.exe process (usually running under Wine) and extracts the
+// credentials from its command line. If the League installation directory
+// can be inferred, it is returned in Credentials.LeaguePath.
+func findCredentialsFromProc(root string, config *Config) (*Credentials, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", root, err)
+	}
+
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue // Not a process directory
+		}
+
+		procDir := filepath.Join(root, entry.Name())
+		data, err := os.ReadFile(filepath.Join(procDir, "cmdline"))
+		if err != nil || len(data) == 0 {
+			continue // Process exited or is a kernel thread
+		}
+
+		args := strings.Split(strings.TrimRight(string(data), "\x00"), "\x00")
+		if !strings.HasSuffix(strings.ToLower(baseName(args[0])), "leagueclientux.exe") {
+			continue
+		}
+
+		creds, err := parseProcessOutput(strings.Join(args, " "))
+		if err != nil {
+			continue
+		}
+
+		if config.Debug {
+			config.Logger.Debug("process", "Found LeagueClientUx.exe with pid %s", entry.Name())
+		}
+
+		if creds.LeaguePath = inferLeaguePath(procDir, args); creds.LeaguePath != "" {
+			if config.Debug {
+				config.Logger.Debug("process", "Found League installation at: %s", creds.LeaguePath)
+			}
+		}
+
+		return creds, nil
+	}
+
+	return nil, fmt.Errorf("no LeagueClientUx.exe process found in %s", root)
+}
+
+// inferLeaguePath maps the Windows path of LeagueClientUx.exe onto the
+// Linux filesystem using the process' Wine prefix.
+func inferLeaguePath(procDir string, args []string) string {
+	exePath := args[0]
+	for _, arg := range args[1:] {
+		if dir, ok := strings.CutPrefix(arg, "--install-directory="); ok {
+			exePath = strings.TrimRight(dir, `\/`) + `\LeagueClientUx.exe`
+			break
+		}
+	}
+
+	// Already a native path, e.g. when started through a launcher script
+	if strings.HasPrefix(exePath, "/") {
+		return filepath.Dir(exePath)
+	}
+
+	// Expect a Windows path such as C:\Riot Games\League of Legends\LeagueClientUx.exe
+	if len(exePath) < 3 || exePath[1] != ':' {
+		return ""
+	}
+
+	prefix := wineprefix(procDir)
+	if prefix == "" {
+		return ""
+	}
+
+	drive := "drive_" + strings.ToLower(exePath[:1])
+	rest := strings.ReplaceAll(exePath[2:], `\`, "/")
+	return filepath.Dir(filepath.Join(prefix, drive, rest))
+}
+
+// wineprefix reads WINEPREFIX from the process environment, falling back to
+// Wine's default of ~/.wine.
+func wineprefix(procDir string) string {
+	data, err := os.ReadFile(filepath.Join(procDir, "environ"))
+	if err != nil {
+		return ""
+	}
+
+	var home string
+	for _, kv := range strings.Split(string(data), "\x00") {
+		if prefix, ok := strings.CutPrefix(kv, "WINEPREFIX="); ok && prefix != "" {
+			return prefix
+		}
+		if dir, ok := strings.CutPrefix(kv, "HOME="); ok {
+			home = dir
+		}
+	}
+
+	if home == "" {
+		return ""
+	}
+	return filepath.Join(home, ".wine")
+}
+
+// baseName returns the last element of a Windows or Unix path
+func baseName(path string) string {
+	if i := strings.LastIndexAny(path, `\/`); i >= 0 {
+		return path[i+1:]
+	}
+	return path
+}
@@ -0,0 +1,95 @@
+package lcu
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeProc creates <root>/<pid>/cmdline and <root>/<pid>/environ with
+// NUL-separated entries, as the kernel exposes them
+func writeProc(t *testing.T, root, pid string, cmdline, environ []string) {
+	t.Helper()
+
+	dir := filepath.Join(root, pid)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]string{"cmdline": cmdline, "environ": environ}
+	for name, entries := range files {
+		data := strings.Join(entries, "\x00") + "\x00"
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFindCredentialsFromProc(t *testing.T) {
+	cmdline := []string{
+		`C:\Riot Games\League of Legends\LeagueClientUx.exe`,
+		"--riotclient-auth-token=riot-token",
+		"--riotclient-app-port=51234",
+		"--remoting-auth-token=Ab1-Cd2_Ef3",
+		"--app-port=54321",
+	}
+
+	tests := []struct {
+		name     string
+		environ  []string
+		wantPath string
+	}{
+		{
+			name:     "WINEPREFIX",
+			environ:  []string{"HOME=/home/player", "WINEPREFIX=/games/league/prefix"},
+			wantPath: "/games/league/prefix/drive_c/Riot Games/League of Legends",
+		},
+		{
+			name:     "HOME fallback",
+			environ:  []string{"USER=player", "HOME=/home/player"},
+			wantPath: "/home/player/.wine/drive_c/Riot Games/League of Legends",
+		},
+		{
+			name:     "no prefix",
+			environ:  []string{"USER=player"},
+			wantPath: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeProc(t, root, "1", []string{"/sbin/init"}, nil)
+			writeProc(t, root, "4242", cmdline, tt.environ)
+			if err := os.MkdirAll(filepath.Join(root, "self"), 0o755); err != nil {
+				t.Fatal(err)
+			}
+
+			creds, err := findCredentialsFromProc(root, &Config{})
+			if err != nil {
+				t.Fatalf("findCredentialsFromProc() error = %v", err)
+			}
+			if creds.Port != 54321 {
+				t.Errorf("Port = %d, want 54321", creds.Port)
+			}
+			if creds.Password != "Ab1-Cd2_Ef3" {
+				t.Errorf("Password = %q, want %q", creds.Password, "Ab1-Cd2_Ef3")
+			}
+			if creds.RiotClientPort != 51234 || creds.RiotClientPassword != "riot-token" {
+				t.Errorf("Riot Client credentials = %d/%q, want 51234/%q", creds.RiotClientPort, creds.RiotClientPassword, "riot-token")
+			}
+			if creds.LeaguePath != tt.wantPath {
+				t.Errorf("LeaguePath = %q, want %q", creds.LeaguePath, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestFindCredentialsFromProcNotRunning(t *testing.T) {
+	root := t.TempDir()
+	writeProc(t, root, "1", []string{"/sbin/init"}, nil)
+
+	if _, err := findCredentialsFromProc(root, &Config{}); err == nil {
+		t.Fatal("findCredentialsFromProc() succeeded without a LeagueClientUx.exe process")
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !windows
+
+package lcu
+
+import "os/exec"
+
+// hideWindow is a no-op outside of Windows
+func hideWindow(cmd *exec.Cmd) {}
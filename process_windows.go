@@ -0,0 +1,15 @@
+//go:build windows
+
+package lcu
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// hideWindow prevents the console window of a helper process from flashing
+func hideWindow(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		HideWindow: true,
+	}
+}
@@ -0,0 +1,351 @@
+// Package readycheck answers ready checks automatically according to a
+// policy. Every decision, including skipped ready checks, is reported
+// through the client's Logger.
+//
+//	auto := readycheck.New(client, readycheck.Policy{
+//		Delay:        2 * time.Second,
+//		Queues:       []int{lcu.QueueRankedSolo, lcu.QueueRankedFlex},
+//		SkipWhenAway: true,
+//		CancelWindow: 5 * time.Second,
+//	})
+//	if err := auto.Start(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+//	defer auto.Stop()
+package readycheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	lcu "github.com/its-haze/lcu-gopher"
+)
+
+// readyCheckEndpoint is the URI the ready check is published on
+const readyCheckEndpoint = "/lol-matchmaking/v1/ready-check"
+
+// Ready check states and responses used by the LCU
+const (
+	stateInProgress = "InProgress"
+	responseNone    = "None"
+)
+
+// Action is the response given to a ready check
+type Action string
+
+const (
+	ActionAccept  Action = "accept"
+	ActionDecline Action = "decline"
+)
+
+// Policy decides how ready checks are answered
+type Policy struct {
+	Action       Action        // Response to give, accept if empty
+	Delay        time.Duration // How long to wait before responding
+	Queues       []int         // Queue IDs to respond in, all queues if empty
+	SkipWhenAway bool          // Leave the ready check alone while the chat status is away
+	CancelWindow time.Duration // How long after accepting Cancel still declines the ready check
+}
+
+// Decision describes how a ready check was handled
+type Decision struct {
+	Action  Action // Response sent, empty if the ready check was left alone
+	Reason  string // Why the ready check was left alone, or why an accept was undone
+	QueueID int    // Queue of the lobby, 0 if unknown
+	At      time.Time
+}
+
+// Auto answers ready checks for a client
+type Auto struct {
+	client *lcu.Client
+	logger lcu.Logger
+	policy Policy
+
+	mu         sync.Mutex
+	sub        *lcu.Subscription
+	ctx        context.Context
+	stop       context.CancelFunc
+	active     bool               // whether a ready check is in progress
+	pending    context.CancelFunc // cancels the response being prepared
+	acceptedAt time.Time          // when the current ready check was accepted
+	queueID    int                // queue of the current ready check
+	onDecision func(Decision)
+}
+
+// New creates an automation for client that answers ready checks
+// according to policy. It does nothing until Start is called.
+func New(client *lcu.Client, policy Policy) *Auto {
+	if policy.Action == "" {
+		policy.Action = ActionAccept
+	}
+	return &Auto{
+		client: client,
+		logger: client.Logger(),
+		policy: policy,
+	}
+}
+
+// OnDecision registers a function that is called with every decision, in
+// addition to it being logged. It replaces any function registered before.
+func (a *Auto) OnDecision(fn func(Decision)) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.onDecision = fn
+}
+
+// Start subscribes to ready check events. A ready check that is already in
+// progress is handled right away.
+//
+// Parameters:
+//   - ctx: Context whose cancellation stops the automation
+//
+// Returns an error if:
+//   - The automation is already started
+//   - Failed to subscribe to ready check events
+func (a *Auto) Start(ctx context.Context) error {
+	a.mu.Lock()
+	if a.stop != nil {
+		a.mu.Unlock()
+		return errors.New("ready check automation already started")
+	}
+	a.ctx, a.stop = context.WithCancel(ctx)
+	a.mu.Unlock()
+
+	sub, err := lcu.SubscribeTypedPattern(a.client, readyCheckEndpoint, func(event *lcu.TypedEvent[lcu.ReadyCheck]) {
+		a.update(&event.Data)
+	}, lcu.EventTypeCreate, lcu.EventTypeUpdate, lcu.EventTypeDelete)
+	if err != nil {
+		a.Stop()
+		return fmt.Errorf("failed to subscribe to ready check: %w", err)
+	}
+
+	a.mu.Lock()
+	a.sub = sub
+	a.mu.Unlock()
+
+	check, err := a.client.GetReadyCheckContext(ctx)
+	switch {
+	case errors.Is(err, lcu.ErrSummonerNotInQueue):
+		// Not in queue, nothing to answer yet
+	case err != nil:
+		a.logger.Error("readycheck", "Failed to get ready check: %v", err)
+	default:
+		a.update(check)
+	}
+	return nil
+}
+
+// Stop unsubscribes from ready check events and abandons a response that
+// has not been sent yet
+func (a *Auto) Stop() error {
+	a.mu.Lock()
+	sub := a.sub
+	a.sub = nil
+	if a.stop != nil {
+		a.stop()
+		a.stop = nil
+	}
+	a.cancelPending()
+	a.active = false
+	a.mu.Unlock()
+
+	if sub == nil {
+		return nil
+	}
+	return sub.Unsubscribe()
+}
+
+// Cancel abandons the response being prepared for the current ready check.
+// If the ready check was accepted less than CancelWindow ago, it is
+// declined instead.
+//
+// Returns whether there was anything to cancel.
+func (a *Auto) Cancel() bool {
+	a.mu.Lock()
+	if a.pending != nil {
+		a.cancelPending()
+		a.mu.Unlock()
+		return true
+	}
+	withinWindow := a.active && !a.acceptedAt.IsZero() && time.Since(a.acceptedAt) < a.policy.CancelWindow
+	ctx, queueID := a.ctx, a.queueID
+	a.acceptedAt = time.Time{}
+	a.mu.Unlock()
+
+	if !withinWindow {
+		return false
+	}
+	if err := a.client.DeclineReadyCheckContext(ctx); err != nil {
+		a.logger.Error("readycheck", "Failed to cancel accepted ready check: %v", err)
+		return false
+	}
+	a.report(Decision{Action: ActionDecline, Reason: "accept cancelled", QueueID: queueID, At: time.Now()})
+	return true
+}
+
+// update handles a new state of the ready check
+func (a *Auto) update(check *lcu.ReadyCheck) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.stop == nil {
+		return
+	}
+
+	if check.State != stateInProgress {
+		// The ready check is over, whatever the outcome
+		a.cancelPending()
+		a.active = false
+		a.acceptedAt = time.Time{}
+		return
+	}
+
+	if check.PlayerResponse != responseNone {
+		if a.pending != nil {
+			a.cancelPending()
+			a.logger.Info("readycheck", "Ready check answered in the client (%s), automatic response cancelled", check.PlayerResponse)
+		}
+		return
+	}
+
+	if a.active {
+		return
+	}
+	a.active = true
+
+	ctx, cancel := context.WithCancel(a.ctx)
+	a.pending = cancel
+	go a.respond(ctx, cancel)
+}
+
+// cancelPending abandons the response being prepared. The caller must hold mu.
+func (a *Auto) cancelPending() {
+	if a.pending != nil {
+		a.pending()
+		a.pending = nil
+	}
+}
+
+// respond applies the policy to a ready check that has just started.
+// cancel is the function stored in pending for ctx.
+func (a *Auto) respond(ctx context.Context, cancel context.CancelFunc) {
+	defer cancel()
+
+	decision := Decision{}
+	final := false
+	defer func() {
+		a.mu.Lock()
+		// A cancelled response has already been replaced or cleared, and a
+		// final one cleared pending itself
+		if !final && ctx.Err() == nil {
+			a.pending = nil
+		}
+		a.mu.Unlock()
+
+		decision.At = time.Now()
+		a.report(decision)
+	}()
+
+	if decision.Reason = a.check(ctx, &decision.QueueID); decision.Reason != "" {
+		return
+	}
+
+	if a.policy.Delay > 0 {
+		timer := time.NewTimer(a.policy.Delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+	}
+
+	// The response is final once pending is cleared, so Cancel and update
+	// can no longer abandon it
+	a.mu.Lock()
+	if ctx.Err() != nil {
+		a.mu.Unlock()
+		decision.Reason = "cancelled before responding"
+		return
+	}
+	a.pending = nil
+	final = true
+	a.mu.Unlock()
+
+	var err error
+	if a.policy.Action == ActionDecline {
+		err = a.client.DeclineReadyCheckContext(ctx)
+	} else {
+		err = a.client.AcceptReadyCheckContext(ctx)
+	}
+	if err != nil {
+		a.logger.Error("readycheck", "Failed to %s ready check: %v", a.policy.Action, err)
+		decision.Reason = err.Error()
+		return
+	}
+	decision.Action = a.policy.Action
+
+	if decision.Action == ActionAccept {
+		a.mu.Lock()
+		if a.active {
+			a.acceptedAt = time.Now()
+			a.queueID = decision.QueueID
+		}
+		a.mu.Unlock()
+	}
+}
+
+// check applies the queue and away rules of the policy and returns why the
+// ready check must be left alone, or an empty string
+func (a *Auto) check(ctx context.Context, queueID *int) string {
+	lobby, err := a.client.GetLobbyContext(ctx)
+	if err == nil {
+		*queueID = lobby.GameConfig.QueueId
+	}
+	if len(a.policy.Queues) > 0 {
+		if err != nil {
+			return fmt.Sprintf("queue unknown: %v", err)
+		}
+		if !slices.Contains(a.policy.Queues, *queueID) {
+			return fmt.Sprintf("queue %d not in policy", *queueID)
+		}
+	}
+
+	if a.policy.SkipWhenAway {
+		me, err := lcu.GetJSONContext[lcu.Friend](ctx, a.client, "/lol-chat/v1/me")
+		if err != nil {
+			return fmt.Sprintf("chat status unknown: %v", err)
+		}
+		if me.Availability == "away" {
+			return "chat status is away"
+		}
+	}
+
+	return ""
+}
+
+// report logs a decision and passes it to the OnDecision function
+func (a *Auto) report(decision Decision) {
+	switch decision.Action {
+	case ActionAccept:
+		a.logger.Info("readycheck", "Ready check accepted (queue %d)", decision.QueueID)
+	case ActionDecline:
+		if decision.Reason != "" {
+			a.logger.Info("readycheck", "Ready check declined (queue %d): %s", decision.QueueID, decision.Reason)
+		} else {
+			a.logger.Info("readycheck", "Ready check declined (queue %d)", decision.QueueID)
+		}
+	default:
+		a.logger.Info("readycheck", "Ready check left alone (queue %d): %s", decision.QueueID, decision.Reason)
+	}
+
+	a.mu.Lock()
+	fn := a.onDecision
+	a.mu.Unlock()
+	if fn != nil {
+		fn(decision)
+	}
+}
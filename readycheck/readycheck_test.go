@@ -0,0 +1,202 @@
+package readycheck_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	lcu "github.com/its-haze/lcu-gopher"
+	"github.com/its-haze/lcu-gopher/lcutest"
+	"github.com/its-haze/lcu-gopher/readycheck"
+)
+
+const readyCheckEndpoint = "/lol-matchmaking/v1/ready-check"
+
+// nopLogger discards all log output
+type nopLogger struct{}
+
+func (nopLogger) Info(endpoint, msg string, args ...interface{})  {}
+func (nopLogger) Error(endpoint, msg string, args ...interface{}) {}
+func (nopLogger) Debug(endpoint, msg string, args ...interface{}) {}
+
+// harness is a started automation connected to a fake LCU in queue queueID
+type harness struct {
+	t         *testing.T
+	server    *lcutest.Server
+	auto      *readycheck.Auto
+	decisions chan readycheck.Decision
+	responses chan string // method and path of every accept or decline
+}
+
+func newHarness(t *testing.T, policy readycheck.Policy, queueID int) *harness {
+	t.Helper()
+
+	h := &harness{
+		t:         t,
+		server:    lcutest.NewServer(),
+		decisions: make(chan readycheck.Decision, 8),
+		responses: make(chan string, 8),
+	}
+	t.Cleanup(h.server.Close)
+
+	h.server.SetResponse("GET", "/lol-lobby/v2/lobby", http.StatusOK, map[string]interface{}{
+		"gameConfig": map[string]int{"queueId": queueID},
+	})
+	for _, path := range []string{readyCheckEndpoint + "/accept", readyCheckEndpoint + "/decline"} {
+		h.server.Handle("POST", path, func(w http.ResponseWriter, r *http.Request) {
+			h.responses <- r.Method + " " + r.URL.Path
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	config := h.server.Config()
+	config.Logger = nopLogger{}
+	client, err := lcu.NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if err := client.Connect(); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	t.Cleanup(func() { client.Disconnect() })
+
+	h.auto = readycheck.New(client, policy)
+	h.auto.OnDecision(func(decision readycheck.Decision) {
+		h.decisions <- decision
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := h.auto.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	t.Cleanup(func() { h.auto.Stop() })
+	if err := h.server.WaitForSubscription(ctx, readyCheckEndpoint); err != nil {
+		t.Fatalf("WaitForSubscription: %v", err)
+	}
+	return h
+}
+
+// publish sends a ready check update with the given state and player response
+func (h *harness) publish(state, playerResponse string) {
+	h.t.Helper()
+
+	check := lcu.ReadyCheck{State: state, PlayerResponse: playerResponse}
+	if err := h.server.Publish(lcu.EventTypeUpdate, readyCheckEndpoint, check); err != nil {
+		h.t.Fatalf("Publish: %v", err)
+	}
+}
+
+func (h *harness) decision() readycheck.Decision {
+	h.t.Helper()
+
+	select {
+	case decision := <-h.decisions:
+		return decision
+	case <-time.After(5 * time.Second):
+		h.t.Fatal("no decision reported")
+	}
+	return readycheck.Decision{}
+}
+
+func (h *harness) response() string {
+	h.t.Helper()
+
+	select {
+	case response := <-h.responses:
+		return response
+	case <-time.After(5 * time.Second):
+		h.t.Fatal("ready check not answered")
+	}
+	return ""
+}
+
+// noResponse fails if the ready check is answered
+func (h *harness) noResponse() {
+	h.t.Helper()
+
+	select {
+	case response := <-h.responses:
+		h.t.Fatalf("unexpected response %s", response)
+	default:
+	}
+}
+
+func TestAcceptAfterDelay(t *testing.T) {
+	const delay = 200 * time.Millisecond
+	h := newHarness(t, readycheck.Policy{Delay: delay}, lcu.QueueRankedSolo)
+
+	started := time.Now()
+	h.publish("InProgress", "None")
+
+	if got, want := h.response(), "POST "+readyCheckEndpoint+"/accept"; got != want {
+		t.Fatalf("response = %s, want %s", got, want)
+	}
+	if elapsed := time.Since(started); elapsed < delay {
+		t.Fatalf("accepted after %v, want at least %v", elapsed, delay)
+	}
+
+	decision := h.decision()
+	if decision.Action != readycheck.ActionAccept || decision.QueueID != lcu.QueueRankedSolo {
+		t.Fatalf("decision = %+v, want accept in queue %d", decision, lcu.QueueRankedSolo)
+	}
+}
+
+func TestQueueNotInPolicy(t *testing.T) {
+	h := newHarness(t, readycheck.Policy{Queues: []int{lcu.QueueRankedSolo}}, lcu.QueueARAM)
+
+	h.publish("InProgress", "None")
+
+	decision := h.decision()
+	if decision.Action != "" || !strings.Contains(decision.Reason, "not in policy") {
+		t.Fatalf("decision = %+v, want the ready check left alone", decision)
+	}
+	h.noResponse()
+}
+
+func TestCancelWithinWindow(t *testing.T) {
+	h := newHarness(t, readycheck.Policy{CancelWindow: 5 * time.Second}, lcu.QueueRankedSolo)
+
+	h.publish("InProgress", "None")
+	h.response()
+	if decision := h.decision(); decision.Action != readycheck.ActionAccept {
+		t.Fatalf("decision = %+v, want accept", decision)
+	}
+	h.publish("InProgress", "Accepted")
+
+	if !h.auto.Cancel() {
+		t.Fatal("Cancel = false within the cancel window")
+	}
+	if got, want := h.response(), "POST "+readyCheckEndpoint+"/decline"; got != want {
+		t.Fatalf("response = %s, want %s", got, want)
+	}
+	if decision := h.decision(); decision.Action != readycheck.ActionDecline || decision.Reason != "accept cancelled" {
+		t.Fatalf("decision = %+v, want the accept cancelled", decision)
+	}
+
+	// The window is used up
+	if h.auto.Cancel() {
+		t.Fatal("Cancel = true after the accept was already cancelled")
+	}
+	h.noResponse()
+}
+
+func TestAnswerInClientCancelsResponse(t *testing.T) {
+	h := newHarness(t, readycheck.Policy{Delay: 10 * time.Second}, lcu.QueueRankedSolo)
+
+	h.publish("InProgress", "None")
+	h.publish("InProgress", "Declined")
+
+	decision := h.decision()
+	if decision.Action != "" || decision.Reason != "cancelled before responding" {
+		t.Fatalf("decision = %+v, want the response cancelled", decision)
+	}
+	h.noResponse()
+
+	// Nothing is left to cancel
+	if h.auto.Cancel() {
+		t.Fatal("Cancel = true after the player answered")
+	}
+}
@@ -0,0 +1,134 @@
+package lcu
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// connectionStateURI is the internal handler key for connection state events.
+// It is never sent to the LCU as a WAMP subscription.
+const connectionStateURI = "OnConnectionStateChange"
+
+// SubscribeToConnectionState registers a handler that is called whenever the
+// WebSocket connection changes state. A Disconnected state followed by
+// Connected means events may have been missed in between, so handlers that
+// mirror LCU state should re-fetch it.
+//
+// Parameters:
+//   - handler: The function that will be called with the new connection state
+//
+// Returns a *Subscription whose Unsubscribe removes the handler.
+func (c *Client) SubscribeToConnectionState(handler func(state ConnectionState)) *Subscription {
+	// No WAMP topics, the events are generated locally
+	sub, _ := c.addSubscription(connectionStateURI, func(event *Event) {
+		if state, ok := event.Data.(ConnectionState); ok {
+			handler(state)
+		}
+	})
+	return sub
+}
+
+func (c *Client) emitConnectionState(state ConnectionState) {
+	handlers := c.handlersFor(connectionStateURI)
+
+	event := &Event{
+		EventType: "ConnectionStateChange",
+		URI:       connectionStateURI,
+		Data:      state,
+	}
+	c.dispatcher.dispatch(connectionStateURI, event, handlers)
+}
+
+// connectionLost is called by the listener when its connection dies.
+// It clears the dead connection and starts the reconnection supervisor.
+// DetectRestart also starts it, as the connection is dropped on purpose
+// to move it onto a relaunched LCU.
+func (c *Client) connectionLost(conn *websocket.Conn) {
+	c.wsLock.Lock()
+	if c.wsConn == conn {
+		c.wsConn = nil
+	}
+	c.wsLock.Unlock()
+	conn.Close()
+
+	c.failPendingCalls(fmt.Errorf("WebSocket connection lost"))
+	c.emitConnectionState(ConnectionStateDisconnected)
+
+	if (c.config.AutoReconnect || c.config.DetectRestart) && c.reconnecting.CompareAndSwap(false, true) {
+		go c.reconnect()
+	}
+}
+
+// reconnect re-establishes the WebSocket connection with exponential backoff
+// and replays every recorded subscription once connected.
+func (c *Client) reconnect() {
+	defer c.reconnecting.Store(false)
+
+	delay := c.config.ReconnectBackoff
+	if delay <= 0 {
+		delay = time.Second
+	}
+	maxDelay := c.config.MaxReconnectBackoff
+	if maxDelay < delay {
+		maxDelay = delay
+	}
+
+	ctx, cancel := c.doneContext()
+	defer cancel()
+
+	c.emitConnectionState(ConnectionStateReconnecting)
+
+	for attempt := 1; ; attempt++ {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		// The LCU may have been relaunched with a new port and token
+		if c.config.DetectRestart {
+			if _, err := c.rotateCredentials(ctx); err != nil {
+				return
+			}
+		}
+
+		c.logger.Debug("websocket", "Reconnection attempt %d", attempt)
+
+		dialCtx, dialCancel := context.WithTimeout(ctx, c.config.Timeout)
+		err := c.connectWebSocket(dialCtx)
+		dialCancel()
+		if err == nil {
+			c.resubscribe()
+			c.logger.Info("websocket", "Reconnected to LCU after %d attempt(s)", attempt)
+			return
+		}
+
+		c.logger.Debug("websocket", "Reconnection attempt %d failed: %v", attempt, err)
+
+		delay *= 2
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
+
+// resubscribe re-sends a WAMP subscribe message for every active topic
+func (c *Client) resubscribe() {
+	c.eventMux.RLock()
+	uris := make([]string, 0, len(c.topics))
+	for uri := range c.topics {
+		uris = append(uris, uri)
+	}
+	c.eventMux.RUnlock()
+
+	for _, uri := range uris {
+		if err := c.sendWebSocketMessage([]interface{}{5, uri}); err != nil {
+			c.logger.Error("websocket", "Failed to replay subscription for %s: %v", uri, err)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package lcu
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// CassetteKind is the kind of traffic a CassetteEntry holds
+type CassetteKind string
+
+const (
+	CassetteHTTP     CassetteKind = "http"   // An HTTP request and its response
+	CassetteReceived CassetteKind = "ws-in"  // A WAMP frame received from the LCU
+	CassetteSent     CassetteKind = "ws-out" // A WAMP frame sent to the LCU
+)
+
+// CassetteEntry is a single line of a cassette
+type CassetteEntry struct {
+	Time time.Time    `json:"time"`
+	Kind CassetteKind `json:"kind"`
+
+	// HTTP traffic
+	Method       string `json:"method,omitempty"`
+	Endpoint     string `json:"endpoint,omitempty"`
+	RequestBody  string `json:"requestBody,omitempty"`
+	StatusCode   int    `json:"statusCode,omitempty"`
+	ResponseBody string `json:"responseBody,omitempty"`
+
+	// WebSocket traffic
+	Frame json.RawMessage `json:"frame,omitempty"`
+}
+
+// Recorder writes LCU traffic to a cassette, one JSON encoded CassetteEntry
+// per line. Set it as Config.Recorder to record every request, response and
+// WAMP frame of a client. It is safe for concurrent use.
+type Recorder struct {
+	mu     sync.Mutex
+	enc    *json.Encoder
+	closer io.Closer
+}
+
+// NewRecorder returns a Recorder writing to w
+func NewRecorder(w io.Writer) *Recorder {
+	r := &Recorder{enc: json.NewEncoder(w)}
+	if closer, ok := w.(io.Closer); ok {
+		r.closer = closer
+	}
+	return r
+}
+
+// CreateCassette creates or truncates the cassette file at path and returns a Recorder writing to it
+func CreateCassette(path string) (*Recorder, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cassette: %w", err)
+	}
+	return NewRecorder(file), nil
+}
+
+// Record appends entry to the cassette. The entry is timestamped if its Time is zero.
+func (r *Recorder) Record(entry CassetteEntry) error {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.enc.Encode(entry)
+}
+
+// Close closes the underlying writer if it is an io.Closer
+func (r *Recorder) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
+
+// LoadCassette reads the cassette file at path
+func LoadCassette(path string) ([]CassetteEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cassette: %w", err)
+	}
+	defer file.Close()
+	return ReadCassette(file)
+}
+
+// ReadCassette reads cassette entries from r until EOF
+func ReadCassette(r io.Reader) ([]CassetteEntry, error) {
+	var entries []CassetteEntry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024) // champ select sessions can be large
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry CassetteEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("invalid cassette entry on line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+	return entries, nil
+}
+
+// record writes entry to the configured recorder, if any
+func (c *Client) record(entry CassetteEntry) {
+	if c.config.Recorder == nil {
+		return
+	}
+	if err := c.config.Recorder.Record(entry); err != nil {
+		c.logger.Error("recorder", "Failed to record %s traffic: %v", entry.Kind, err)
+	}
+}
@@ -0,0 +1,121 @@
+package lcu
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Credentials returns the credentials currently used by the client.
+// They change when DetectRestart is enabled and the LCU is relaunched.
+func (c *Client) Credentials() *Credentials {
+	return c.credentials.Load()
+}
+
+// RefreshCredentials re-runs credential discovery and swaps in the result.
+// Requests and WebSocket connections made afterwards use the new credentials;
+// an already open WebSocket is reconnected if the credentials changed.
+//
+// Returns an error if no running LCU instance could be found.
+func (c *Client) RefreshCredentials(ctx context.Context) error {
+	c.rotateMu.Lock()
+	defer c.rotateMu.Unlock()
+
+	creds, err := findCredentials(ctx, c.config)
+	if err != nil {
+		return fmt.Errorf("failed to find LCU credentials: %w", err)
+	}
+
+	if c.setCredentials(creds) {
+		c.dropWebSocket()
+	}
+	return nil
+}
+
+// setCredentials atomically swaps the credentials and reports whether they changed
+func (c *Client) setCredentials(creds *Credentials) bool {
+	old := c.credentials.Swap(creds)
+	if old != nil && old.Port == creds.Port && old.Password == creds.Password {
+		return false
+	}
+
+	if old != nil {
+		c.logger.Info("connection", "LCU restarted, switching from port %d to port %d", old.Port, creds.Port)
+	}
+	return true
+}
+
+// rotateCredentials blocks until the LCU is reachable again. If the current
+// credentials fail the health check, it waits for a new LCU instance and
+// swaps in its credentials. It reports whether the credentials changed.
+func (c *Client) rotateCredentials(ctx context.Context) (bool, error) {
+	c.rotateMu.Lock()
+	defer c.rotateMu.Unlock()
+
+	current := c.Credentials()
+	if checkLCUHealth(ctx, c.config, current, c.config.Timeout, c.logger) {
+		return false, nil
+	}
+
+	c.logger.Info("connection", "LCU on port %d is unreachable, waiting for it to restart", current.Port)
+
+	creds, err := waitForCredentials(ctx, c.config)
+	if err != nil {
+		return false, err
+	}
+
+	return c.setCredentials(creds), nil
+}
+
+// monitorHealth periodically health checks the LCU and rotates the
+// credentials when it goes away, so HTTP-only users also survive a restart.
+func (c *Client) monitorHealth() {
+	ctx, cancel := c.doneContext()
+	defer cancel()
+
+	ticker := time.NewTicker(c.config.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		changed, err := c.rotateCredentials(ctx)
+		if err != nil {
+			return
+		}
+
+		// Force the WebSocket onto the new instance
+		if changed {
+			c.dropWebSocket()
+		}
+	}
+}
+
+// dropWebSocket closes the current WebSocket connection, which lets the
+// listener hand over to the reconnection supervisor.
+func (c *Client) dropWebSocket() {
+	c.wsLock.RLock()
+	conn := c.wsConn
+	c.wsLock.RUnlock()
+
+	if conn != nil {
+		conn.Close()
+	}
+}
+
+// doneContext returns a context that is cancelled when the client is disconnected
+func (c *Client) doneContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-c.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
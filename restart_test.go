@@ -0,0 +1,87 @@
+package lcu_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lcu "github.com/its-haze/lcu-gopher"
+	"github.com/its-haze/lcu-gopher/lcutest"
+)
+
+// nopLogger discards all log output
+type nopLogger struct{}
+
+func (nopLogger) Info(endpoint, msg string, args ...interface{})  {}
+func (nopLogger) Error(endpoint, msg string, args ...interface{}) {}
+func (nopLogger) Debug(endpoint, msg string, args ...interface{}) {}
+
+func TestDetectRestartWithoutAutoReconnect(t *testing.T) {
+	first := lcutest.NewServer()
+	defer first.Close()
+
+	var current atomic.Pointer[lcutest.Server]
+	current.Store(first)
+
+	config := first.Config()
+	config.Logger = nopLogger{}
+	config.Credentials = nil
+	config.CredentialProviders = []lcu.CredentialProvider{
+		lcu.CredentialProviderFunc(func(ctx context.Context, config *lcu.Config) (*lcu.Credentials, error) {
+			return current.Load().Credentials(), nil
+		}),
+	}
+	config.AutoReconnect = false
+	config.DetectRestart = true
+	config.HealthCheckInterval = 50 * time.Millisecond
+	config.PollInterval = 50 * time.Millisecond
+	config.Timeout = time.Second
+
+	client, err := lcu.NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if err := client.Connect(); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	defer client.Disconnect()
+
+	events := make(chan *lcu.Event, 1)
+	if _, err := client.Subscribe("/lol-lobby/v2/lobby", func(event *lcu.Event) {
+		events <- event
+	}, lcu.EventTypeUpdate); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := first.WaitForSubscription(ctx, "/lol-lobby/v2/lobby"); err != nil {
+		t.Fatalf("subscription to first instance: %v", err)
+	}
+
+	// Relaunch the LCU on a new port
+	second := lcutest.NewServer()
+	defer second.Close()
+	current.Store(second)
+	first.Close()
+
+	if err := second.WaitForSubscription(ctx, "/lol-lobby/v2/lobby"); err != nil {
+		t.Fatalf("subscription to restarted instance: %v", err)
+	}
+	if got := client.Credentials().Port; got != second.Port() {
+		t.Fatalf("credentials port = %d, want %d", got, second.Port())
+	}
+
+	if err := second.Publish(lcu.EventTypeUpdate, "/lol-lobby/v2/lobby", map[string]string{"partyId": "p1"}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	select {
+	case event := <-events:
+		if event.URI != "/lol-lobby/v2/lobby" {
+			t.Fatalf("event URI = %q", event.URI)
+		}
+	case <-ctx.Done():
+		t.Fatal("no event received from restarted instance")
+	}
+}
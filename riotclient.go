@@ -0,0 +1,226 @@
+package lcu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// riotClientHealthEndpoint is requested to check the Riot Client is ready. It
+// answers before the user has logged in.
+const riotClientHealthEndpoint = "/riotclient/region-locale"
+
+// RiotClient is a client for the Riot Client API, which runs before and
+// alongside the League client and handles login and product launches. It
+// shares the request, subscription and WAMP machinery of Client; the League
+// specific helpers of Client do not apply to it.
+type RiotClient struct {
+	*Client
+}
+
+// ProductSession is a running Riot product, e.g. the League client
+type ProductSession struct {
+	ProductID           string `json:"productId"`
+	PatchlineFullName   string `json:"patchlineFullName"`
+	PatchlineID         string `json:"patchlineId"`
+	Phase               string `json:"phase"`
+	Version             string `json:"version"`
+	LaunchConfiguration struct {
+		Arguments        []string `json:"arguments"`
+		Executable       string   `json:"executable"`
+		Locale           string   `json:"locale"`
+		WorkingDirectory string   `json:"workingDirectory"`
+	} `json:"launchConfiguration"`
+}
+
+// RegionLocale is the region and language the Riot Client is set to
+type RegionLocale struct {
+	Locale      string `json:"locale"`
+	Region      string `json:"region"`
+	WebLanguage string `json:"webLanguage"`
+	WebRegion   string `json:"webRegion"`
+}
+
+// NewRiotClient creates a new Riot Client API client with the given configuration.
+// The Riot Client's own lockfile is read first, so it works before the
+// League client is running; the LeagueClientUx command line is the fallback.
+//
+// Parameters:
+//   - config: The client configuration, or nil for DefaultConfig. Explicit
+//     Credentials and CredentialProviders are used as is.
+//
+// Returns:
+//   - *RiotClient: The new client, not yet connected
+//   - error: An error if no running Riot Client was found
+func NewRiotClient(config *Config) (*RiotClient, error) {
+	return NewRiotClientContext(context.Background(), config)
+}
+
+// NewRiotClientContext is like NewRiotClient but uses the provided context while looking up credentials
+func NewRiotClientContext(ctx context.Context, config *Config) (*RiotClient, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	cfg := *config
+	if len(cfg.CredentialProviders) == 0 {
+		cfg.CredentialProviders = []CredentialProvider{RiotClientLockfileProvider(), RiotClientProcessProvider()}
+	}
+	if cfg.HealthEndpoint == "" || cfg.HealthEndpoint == lcuHealthEndpoint {
+		cfg.HealthEndpoint = riotClientHealthEndpoint
+	}
+
+	client, err := NewClientContext(ctx, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &RiotClient{Client: client}, nil
+}
+
+// RiotClientLockfileProvider reads the credentials from the Riot Client's
+// lockfile in Config.RiotClientPath or the platform-specific default location
+func RiotClientLockfileProvider() CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context, config *Config) (*Credentials, error) {
+		for _, path := range riotClientLockfilePaths(config) {
+			if config.Debug {
+				config.Logger.Debug("lockfile", "Trying Riot Client lockfile path: %s", path)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				continue // Try next path
+			}
+			if creds, err := parseLockfile(data); err == nil {
+				return creds, nil
+			}
+		}
+		return nil, fmt.Errorf("Riot Client lockfile not found")
+	})
+}
+
+// RiotClientProcessProvider reads the Riot Client credentials passed on the
+// command line of the running LeagueClientUx process
+func RiotClientProcessProvider() CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context, config *Config) (*Credentials, error) {
+		creds, err := findCredentialsFromProcess(config)
+		if err != nil {
+			return nil, err
+		}
+		if creds.RiotClientPort == 0 {
+			return nil, fmt.Errorf("LeagueClientUx was started without Riot Client credentials")
+		}
+		return &Credentials{
+			Port:     creds.RiotClientPort,
+			Password: creds.RiotClientPassword,
+			Protocol: "https",
+		}, nil
+	})
+}
+
+// riotClientLockfilePaths returns the possible locations of the Riot Client lockfile
+func riotClientLockfilePaths(config *Config) []string {
+	var possiblePaths []string
+
+	// If a custom path is provided, use it first
+	if config.RiotClientPath != "" {
+		possiblePaths = append(possiblePaths, filepath.Join(config.RiotClientPath, "lockfile"))
+	}
+
+	configDir := filepath.Join("Riot Games", "Riot Client", "Config", "lockfile")
+	switch runtime.GOOS {
+	case "windows":
+		if dir := os.Getenv("LOCALAPPDATA"); dir != "" {
+			possiblePaths = append(possiblePaths, filepath.Join(dir, configDir))
+		}
+	case "darwin":
+		if home, err := os.UserHomeDir(); err == nil {
+			possiblePaths = append(possiblePaths, filepath.Join(home, "Library", "Application Support", configDir))
+		}
+	case "linux":
+		// Wine/Lutris prefixes and WSL keep it in the Windows user's local app data
+		var patterns []string
+		if prefix := os.Getenv("WINEPREFIX"); prefix != "" {
+			patterns = append(patterns, filepath.Join(prefix, "drive_c", "users", "*", "AppData", "Local", configDir))
+		}
+		if home, err := os.UserHomeDir(); err == nil {
+			patterns = append(patterns, filepath.Join(home, ".wine", "drive_c", "users", "*", "AppData", "Local", configDir))
+		}
+		patterns = append(patterns, filepath.Join("/mnt", "c", "Users", "*", "AppData", "Local", configDir))
+		for _, pattern := range patterns {
+			matches, _ := filepath.Glob(pattern)
+			possiblePaths = append(possiblePaths, matches...)
+		}
+	}
+
+	return possiblePaths
+}
+
+// IsLoggedIn reports whether a user is logged in to the Riot Client
+func (r *RiotClient) IsLoggedIn() (bool, error) {
+	return r.IsLoggedInContext(context.Background())
+}
+
+// IsLoggedInContext is like IsLoggedIn but uses the provided context
+func (r *RiotClient) IsLoggedInContext(ctx context.Context) (bool, error) {
+	_, err := GetJSONContext[struct{}](ctx, r.Client, "/rso-auth/v1/authorization")
+	if err == nil {
+		return true, nil
+	}
+
+	// The authorization only exists once logged in
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	return false, fmt.Errorf("failed to get login state: %w", err)
+}
+
+// GetProductSessions returns the running Riot products keyed by session ID
+func (r *RiotClient) GetProductSessions() (map[string]ProductSession, error) {
+	return r.GetProductSessionsContext(context.Background())
+}
+
+// GetProductSessionsContext is like GetProductSessions but uses the provided context
+func (r *RiotClient) GetProductSessionsContext(ctx context.Context) (map[string]ProductSession, error) {
+	sessions, err := GetJSONContext[map[string]ProductSession](ctx, r.Client, "/product-session/v1/external-sessions")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product sessions: %w", err)
+	}
+
+	return *sessions, nil
+}
+
+// GetRegionLocale returns the region and language the Riot Client is set to
+func (r *RiotClient) GetRegionLocale() (*RegionLocale, error) {
+	return r.GetRegionLocaleContext(context.Background())
+}
+
+// GetRegionLocaleContext is like GetRegionLocale but uses the provided context
+func (r *RiotClient) GetRegionLocaleContext(ctx context.Context) (*RegionLocale, error) {
+	locale, err := GetJSONContext[RegionLocale](ctx, r.Client, riotClientHealthEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get region and locale: %w", err)
+	}
+
+	return locale, nil
+}
+
+// LaunchProduct launches a Riot product, e.g. "league_of_legends" on the "live" patchline
+func (r *RiotClient) LaunchProduct(product, patchline string) error {
+	return r.LaunchProductContext(context.Background(), product, patchline)
+}
+
+// LaunchProductContext is like LaunchProduct but uses the provided context
+func (r *RiotClient) LaunchProductContext(ctx context.Context, product, patchline string) error {
+	endpoint := "/product-launcher/v1/products/" + url.PathEscape(product) + "/patchlines/" + url.PathEscape(patchline)
+	if _, err := PostJSONContext[struct{}, struct{}](ctx, r.Client, endpoint, struct{}{}); err != nil {
+		return fmt.Errorf("failed to launch %s: %w", product, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,454 @@
+// Package store keeps an in-memory copy of LCU resources. Each tracked
+// resource is fetched once and then kept current from the Create, Update
+// and Delete events published on the same URI, so reads never hit the client.
+//
+//	s := store.New(client)
+//	defer s.Close()
+//	if err := s.Track(ctx, store.URILobby, store.URIChampSelectSession); err != nil {
+//		log.Fatal(err)
+//	}
+//	lobby, err := s.Lobby()
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	lcu "github.com/its-haze/lcu-gopher"
+)
+
+// URIs of the resources with typed getters
+const (
+	URICurrentSummoner        = "/lol-summoner/v1/current-summoner"
+	URIGameSession            = "/lol-gameflow/v1/session"
+	URILobby                  = "/lol-lobby/v2/lobby"
+	URIMatchmakingSearchState = "/lol-lobby/v2/lobby/matchmaking/search-state"
+	URIChampSelectSession     = "/lol-champ-select/v1/session"
+)
+
+// ErrNotFound is returned when a resource is not tracked or does not
+// currently exist, such as the lobby while not in a lobby
+var ErrNotFound = errors.New("resource not found in store")
+
+// Change describes a change to a tracked resource
+type Change struct {
+	URI       string
+	EventType lcu.EventType   // Create, Update or Delete
+	Data      json.RawMessage // New data, nil when deleted
+}
+
+// Decode decodes the new data of the resource into v
+func (c Change) Decode(v interface{}) error {
+	return json.Unmarshal(c.Data, v)
+}
+
+// resource is the stored state of a tracked URI
+type resource struct {
+	data    json.RawMessage // nil while the resource does not exist
+	version uint64          // incremented for every event received
+	sub     *lcu.Subscription
+}
+
+// Store mirrors LCU resources in memory
+type Store struct {
+	client *lcu.Client
+	logger lcu.Logger
+
+	mu        sync.RWMutex
+	resources map[string]*resource
+	watchers  map[string][]*Watcher
+	closed    bool
+
+	stateSub *lcu.Subscription
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+// New creates an empty store for client. Resources are added with Track.
+// Tracked resources are fetched again whenever the client reconnects, as
+// events may have been missed while it was disconnected.
+func New(client *lcu.Client) *Store {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Store{
+		client:    client,
+		logger:    client.Logger(),
+		resources: make(map[string]*resource),
+		watchers:  make(map[string][]*Watcher),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+	s.stateSub = client.SubscribeToConnectionState(func(state lcu.ConnectionState) {
+		if state == lcu.ConnectionStateConnected {
+			go s.refresh()
+		}
+	})
+	return s
+}
+
+// Track subscribes to the events of each URI and fetches its current data.
+// URIs that are already tracked are skipped. A URI that returns 404 is
+// tracked as not existing until it is created.
+//
+// Parameters:
+//   - ctx: Context for the initial fetches
+//   - uris: Exact endpoint URIs; patterns cannot be fetched and are rejected
+//
+// Returns an error if:
+//   - The store is closed
+//   - A URI is a pattern
+//   - Failed to subscribe to a URI or to fetch its data
+func (s *Store) Track(ctx context.Context, uris ...string) error {
+	for _, uri := range uris {
+		if strings.HasPrefix(uri, "^") || strings.ContainsAny(uri, "*?[") {
+			return fmt.Errorf("cannot track pattern %q", uri)
+		}
+
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			return errors.New("store is closed")
+		}
+		if _, ok := s.resources[uri]; ok {
+			s.mu.Unlock()
+			continue
+		}
+		res := &resource{}
+		s.resources[uri] = res
+		s.mu.Unlock()
+
+		// Subscribe before fetching so no change is missed in between
+		sub, err := s.client.Subscribe(uri, s.handleEvent,
+			lcu.EventTypeCreate, lcu.EventTypeUpdate, lcu.EventTypeDelete)
+		if err != nil {
+			s.mu.Lock()
+			delete(s.resources, uri)
+			s.mu.Unlock()
+			return fmt.Errorf("failed to track %s: %w", uri, err)
+		}
+		s.mu.Lock()
+		res.sub = sub
+		s.mu.Unlock()
+
+		if err := s.seed(ctx, uri); err != nil {
+			s.Untrack(uri)
+			return fmt.Errorf("failed to track %s: %w", uri, err)
+		}
+	}
+	return nil
+}
+
+// Untrack unsubscribes from uri and forgets its data
+func (s *Store) Untrack(uri string) error {
+	s.mu.Lock()
+	res, ok := s.resources[uri]
+	delete(s.resources, uri)
+	s.mu.Unlock()
+
+	if !ok || res.sub == nil {
+		return nil
+	}
+	return res.sub.Unsubscribe()
+}
+
+// Close untracks every resource and closes all watchers
+func (s *Store) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	resources := s.resources
+	s.resources = make(map[string]*resource)
+	var watchers []*Watcher
+	for _, list := range s.watchers {
+		watchers = append(watchers, list...)
+	}
+	s.mu.Unlock()
+
+	s.cancel()
+	s.stateSub.Unsubscribe()
+	for _, w := range watchers {
+		w.Close()
+	}
+
+	var firstErr error
+	for _, res := range resources {
+		if res.sub == nil {
+			continue
+		}
+		if err := res.sub.Unsubscribe(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// seed fetches the current data of uri. Data received as an event while the
+// request was in flight is newer, so the fetched data is then dropped.
+func (s *Store) seed(ctx context.Context, uri string) error {
+	s.mu.RLock()
+	res, ok := s.resources[uri]
+	var version uint64
+	if ok {
+		version = res.version
+	}
+	s.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	var data json.RawMessage
+	raw, err := lcu.GetJSONContext[json.RawMessage](ctx, s.client, uri)
+	var apiErr *lcu.APIError
+	switch {
+	case errors.As(err, &apiErr) && apiErr.StatusCode == 404:
+		// The resource does not exist yet
+	case err != nil:
+		return err
+	default:
+		data = *raw
+	}
+
+	s.update(uri, data, func(res *resource) bool { return res.version == version })
+	return nil
+}
+
+// refresh fetches every tracked resource again
+func (s *Store) refresh() {
+	s.mu.RLock()
+	uris := make([]string, 0, len(s.resources))
+	for uri := range s.resources {
+		uris = append(uris, uri)
+	}
+	s.mu.RUnlock()
+
+	for _, uri := range uris {
+		if err := s.seed(s.ctx, uri); err != nil && s.ctx.Err() == nil {
+			s.logger.Error("store", "Failed to refresh %s: %v", uri, err)
+		}
+	}
+}
+
+// handleEvent applies an event to the resource it was published for
+func (s *Store) handleEvent(event *lcu.Event) {
+	var data json.RawMessage
+	if event.EventType != string(lcu.EventTypeDelete) {
+		data = event.Raw
+		if data == nil {
+			// Events built locally carry no raw payload
+			raw, err := json.Marshal(event.Data)
+			if err != nil {
+				s.logger.Error("store", "Skipping event for %s: %v", event.URI, err)
+				return
+			}
+			data = raw
+		}
+	}
+
+	s.update(event.URI, data, func(res *resource) bool {
+		res.version++
+		return true
+	})
+}
+
+// update stores data for uri if apply allows it and notifies the watchers
+// of uri when the data changed
+func (s *Store) update(uri string, data json.RawMessage, apply func(*resource) bool) {
+	// Compacting copies the data and makes fetched and received data comparable
+	if data != nil {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, data); err != nil {
+			s.logger.Error("store", "Skipping invalid data for %s: %v", uri, err)
+			return
+		}
+		data = buf.Bytes()
+		if bytes.Equal(data, []byte("null")) {
+			data = nil
+		}
+	}
+
+	s.mu.Lock()
+	res, ok := s.resources[uri]
+	if !ok || !apply(res) {
+		s.mu.Unlock()
+		return
+	}
+
+	old := res.data
+	res.data = data
+	if bytes.Equal(old, data) {
+		s.mu.Unlock()
+		return
+	}
+
+	change := Change{URI: uri, EventType: lcu.EventTypeUpdate, Data: data}
+	switch {
+	case old == nil:
+		change.EventType = lcu.EventTypeCreate
+	case data == nil:
+		change.EventType = lcu.EventTypeDelete
+	}
+	watchers := append([]*Watcher(nil), s.watchers[uri]...)
+	s.mu.Unlock()
+
+	for _, w := range watchers {
+		w.send(change)
+	}
+}
+
+// Raw returns a copy of the stored JSON data of uri.
+//
+// Returns ErrNotFound if uri is not tracked or does not currently exist.
+func (s *Store) Raw(uri string) (json.RawMessage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res, ok := s.resources[uri]
+	if !ok || res.data == nil {
+		return nil, ErrNotFound
+	}
+	return append(json.RawMessage(nil), res.data...), nil
+}
+
+// Get decodes the stored data of uri into a new T. Every call returns a
+// fresh value, so callers may modify it.
+//
+// Returns ErrNotFound if uri is not tracked or does not currently exist.
+func Get[T any](s *Store, uri string) (*T, error) {
+	s.mu.RLock()
+	res, ok := s.resources[uri]
+	var data json.RawMessage
+	if ok {
+		data = res.data
+	}
+	s.mu.RUnlock()
+
+	if data == nil {
+		return nil, ErrNotFound
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", uri, err)
+	}
+	return &v, nil
+}
+
+// CurrentSummoner returns the stored current summoner. URICurrentSummoner must be tracked.
+func (s *Store) CurrentSummoner() (*lcu.Summoner, error) {
+	return Get[lcu.Summoner](s, URICurrentSummoner)
+}
+
+// GameSession returns the stored game session. URIGameSession must be tracked.
+func (s *Store) GameSession() (*lcu.GameSession, error) {
+	return Get[lcu.GameSession](s, URIGameSession)
+}
+
+// Lobby returns the stored lobby. URILobby must be tracked.
+func (s *Store) Lobby() (*lcu.Lobby, error) {
+	return Get[lcu.Lobby](s, URILobby)
+}
+
+// MatchmakingSearchState returns the stored matchmaking search state.
+// URIMatchmakingSearchState must be tracked.
+func (s *Store) MatchmakingSearchState() (*lcu.MatchmakingSearchState, error) {
+	return Get[lcu.MatchmakingSearchState](s, URIMatchmakingSearchState)
+}
+
+// ChampSelectSession returns the stored champion select session.
+// URIChampSelectSession must be tracked.
+func (s *Store) ChampSelectSession() (*lcu.ChampSelectSession, error) {
+	return Get[lcu.ChampSelectSession](s, URIChampSelectSession)
+}
+
+// Watcher delivers the changes of a resource on a buffered channel. Changes
+// that arrive while the channel is full are dropped and counted.
+type Watcher struct {
+	// C receives the changes. It is closed when the watcher is closed.
+	C <-chan Change
+
+	store   *Store
+	uri     string
+	ch      chan Change
+	mu      sync.Mutex
+	closed  bool
+	dropped atomic.Uint64
+	once    sync.Once
+}
+
+// Watch returns a watcher for the changes of uri. Only changes of tracked
+// URIs are seen, including those found by the fetch after a reconnect.
+// The watcher is closed by Close or when the store is closed.
+func (s *Store) Watch(uri string) *Watcher {
+	ch := make(chan Change, 16)
+	w := &Watcher{
+		C:     ch,
+		store: s,
+		uri:   uri,
+		ch:    ch,
+	}
+
+	s.mu.Lock()
+	closed := s.closed
+	if !closed {
+		s.watchers[uri] = append(s.watchers[uri], w)
+	}
+	s.mu.Unlock()
+
+	if closed {
+		w.Close()
+	}
+	return w
+}
+
+// Dropped returns the number of changes discarded because the channel was full
+func (w *Watcher) Dropped() uint64 {
+	return w.dropped.Load()
+}
+
+// Close stops the watcher and closes its channel. It is safe to call more than once.
+func (w *Watcher) Close() {
+	w.once.Do(func() {
+		s := w.store
+		s.mu.Lock()
+		list := s.watchers[w.uri]
+		for i, other := range list {
+			if other == w {
+				list = append(list[:i:i], list[i+1:]...)
+				break
+			}
+		}
+		if len(list) == 0 {
+			delete(s.watchers, w.uri)
+		} else {
+			s.watchers[w.uri] = list
+		}
+		s.mu.Unlock()
+
+		w.mu.Lock()
+		w.closed = true
+		close(w.ch)
+		w.mu.Unlock()
+	})
+}
+
+func (w *Watcher) send(change Change) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return
+	}
+	select {
+	case w.ch <- change:
+	default:
+		w.dropped.Add(1)
+	}
+}
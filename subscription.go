@@ -0,0 +1,197 @@
+package lcu
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// errNotConnected is returned when a WebSocket message is sent without a connection
+var errNotConnected = errors.New("WebSocket connection not established")
+
+// Subscription is a handle to a single handler registered with Subscribe.
+// Unsubscribing removes only that handler; the WAMP topic is unsubscribed
+// once no other subscription needs it.
+type Subscription struct {
+	client   *Client
+	id       uint64
+	endpoint string
+	key      string // key of the handler in Client.handlers
+	pattern  bool   // whether endpoint is registered in Client.patterns
+	topics   []string
+	group    []*Subscription // subscriptions unsubscribed together with this one
+	once     sync.Once
+}
+
+// patternKeyPrefix namespaces pattern handlers in Client.handlers so they
+// never collide with exact URI handlers
+const patternKeyPrefix = "pattern:"
+
+// handlerEntry is a handler stored in Client.handlers, tagged with the ID of
+// the subscription that registered it
+type handlerEntry struct {
+	id      uint64
+	handler EventHandler
+}
+
+// Endpoint returns the endpoint or pattern the subscription was registered for
+func (s *Subscription) Endpoint() string {
+	return s.endpoint
+}
+
+// Unsubscribe removes the handler. It is safe to call more than once.
+//
+// Returns an error if:
+//   - Failed to send unsubscription message via WebSocket
+func (s *Subscription) Unsubscribe() error {
+	var err error
+	s.once.Do(func() {
+		if s.group != nil {
+			for _, sub := range s.group {
+				if subErr := sub.Unsubscribe(); subErr != nil && err == nil {
+					err = subErr
+				}
+			}
+			return
+		}
+		err = s.client.removeSubscription(s)
+	})
+	return err
+}
+
+// addSubscription registers handler under key and acquires a reference on
+// each WAMP topic, sending a subscribe message for topics that are new.
+func (c *Client) addSubscription(key string, handler EventHandler, topics ...string) (*Subscription, error) {
+	return c.register(&Subscription{
+		endpoint: key,
+		key:      key,
+		topics:   topics,
+	}, handler)
+}
+
+// addPatternSubscription registers handler for every event URI matching pattern
+func (c *Client) addPatternSubscription(pattern string, handler EventHandler, topics ...string) (*Subscription, error) {
+	return c.register(&Subscription{
+		endpoint: pattern,
+		key:      patternKeyPrefix + pattern,
+		pattern:  true,
+		topics:   topics,
+	}, handler)
+}
+
+// register stores sub and its handler in the internal maps and subscribes
+// to the WAMP topics that are new
+func (c *Client) register(sub *Subscription, handler EventHandler) (*Subscription, error) {
+	sub.client = c
+	sub.id = c.subSeq.Add(1)
+
+	c.eventMux.Lock()
+	if sub.pattern {
+		if err := c.patterns.add(sub.endpoint); err != nil {
+			c.eventMux.Unlock()
+			return nil, err
+		}
+	}
+	c.handlers[sub.key] = append(c.handlers[sub.key], handlerEntry{id: sub.id, handler: handler})
+	c.subscriptions[sub.id] = sub
+	var newTopics []string
+	for _, topic := range sub.topics {
+		c.topics[topic]++
+		if c.topics[topic] == 1 {
+			newTopics = append(newTopics, topic)
+		}
+	}
+	c.eventMux.Unlock()
+
+	for _, uri := range newTopics {
+		message := []interface{}{5, uri}
+		if err := c.sendWebSocketMessage(message); err != nil {
+			// The topic is recorded and will be replayed once reconnected
+			if c.reconnecting.Load() {
+				c.logger.Debug("websocket", "Deferring subscription to %s until reconnected", uri)
+				continue
+			}
+			c.removeSubscription(sub)
+			return nil, fmt.Errorf("failed to send subscription message for %s: %w", uri, err)
+		}
+	}
+
+	return sub, nil
+}
+
+// removeSubscription removes the handler of sub from the internal maps and
+// sends an unsubscribe message for each topic that is no longer needed.
+func (c *Client) removeSubscription(sub *Subscription) error {
+	c.eventMux.Lock()
+	if _, ok := c.subscriptions[sub.id]; !ok {
+		c.eventMux.Unlock()
+		return nil
+	}
+	delete(c.subscriptions, sub.id)
+
+	entries := c.handlers[sub.key]
+	for i, entry := range entries {
+		if entry.id == sub.id {
+			entries = append(entries[:i:i], entries[i+1:]...)
+			break
+		}
+	}
+	if len(entries) == 0 {
+		delete(c.handlers, sub.key)
+	} else {
+		c.handlers[sub.key] = entries
+	}
+
+	if sub.pattern {
+		c.patterns.remove(sub.endpoint)
+	}
+
+	var unusedTopics []string
+	for _, topic := range sub.topics {
+		c.topics[topic]--
+		if c.topics[topic] <= 0 {
+			delete(c.topics, topic)
+			unusedTopics = append(unusedTopics, topic)
+		}
+	}
+	c.eventMux.Unlock()
+
+	// Send unsubscription message via WebSocket (WAMP protocol)
+	var firstErr error
+	for _, uri := range unusedTopics {
+		err := c.sendWebSocketMessage([]interface{}{6, uri})
+		if errors.Is(err, errNotConnected) {
+			continue // Without a connection there is nothing to unsubscribe from
+		}
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to send unsubscription message for %s: %w", uri, err)
+		}
+	}
+	return firstErr
+}
+
+// matchPatterns returns the handler keys of every pattern matching uri
+func (c *Client) matchPatterns(uri string) []string {
+	c.eventMux.RLock()
+	defer c.eventMux.RUnlock()
+
+	var keys []string
+	for _, pattern := range c.patterns.match(uri) {
+		keys = append(keys, patternKeyPrefix+pattern)
+	}
+	return keys
+}
+
+// handlersFor returns the handlers registered under the given keys
+func (c *Client) handlersFor(keys ...string) []EventHandler {
+	c.eventMux.RLock()
+	defer c.eventMux.RUnlock()
+
+	var handlers []EventHandler
+	for _, key := range keys {
+		for _, entry := range c.handlers[key] {
+			handlers = append(handlers, entry.handler)
+		}
+	}
+	return handlers
+}
@@ -0,0 +1,202 @@
+package lcu
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// TypedEvent is an event whose data has been decoded into a T
+type TypedEvent[T any] struct {
+	EventType string
+	URI       string
+	Data      T      // Zero for Delete events, which carry no data
+	Event     *Event // The undecoded event
+}
+
+// Decode decodes the event data into v, which must be a pointer
+func (e *Event) Decode(v interface{}) error {
+	raw := e.Raw
+	if raw == nil {
+		// Events not read from the WebSocket, such as connection state changes
+		var err error
+		if raw, err = json.Marshal(e.Data); err != nil {
+			return fmt.Errorf("failed to encode event data: %w", err)
+		}
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("failed to decode event data for %s: %w", e.URI, err)
+	}
+	return nil
+}
+
+// Value decodes the event data into a new value of the type registered for
+// the event's URI with RegisterEventType and returns a pointer to it.
+//
+// Returns an error if no type is registered for the URI or the data could not be decoded.
+func (e *Event) Value() (interface{}, error) {
+	t, ok := eventDataTypes.lookup(e.URI)
+	if !ok {
+		return nil, fmt.Errorf("no event type registered for %s", e.URI)
+	}
+
+	v := reflect.New(t).Interface()
+	if err := e.Decode(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// typeRegistry maps URI patterns to the Go type of their event data
+type typeRegistry struct {
+	mu       sync.RWMutex
+	patterns *patternIndex
+	types    map[string]reflect.Type
+}
+
+// eventDataTypes is the registry used by RegisterEventType, SubscribeTyped and Event.Value
+var eventDataTypes = &typeRegistry{
+	patterns: newPatternIndex(),
+	types:    make(map[string]reflect.Type),
+}
+
+// The payload types of the endpoints the library has types for
+func init() {
+	RegisterEventType[Summoner]("/lol-summoner/v1/current-summoner")
+	RegisterEventType[ChampSelectSession]("/lol-champ-select/v1/session")
+	RegisterEventType[Lobby]("/lol-lobby/v2/lobby")
+	RegisterEventType[MatchmakingSearchState]("/lol-lobby/v2/lobby/matchmaking/search-state")
+	RegisterEventType[ReadyCheck]("/lol-matchmaking/v1/ready-check")
+	RegisterEventType[GameSession]("/lol-gameflow/v1/session")
+	RegisterEventType[GamePhase]("/lol-gameflow/v1/gameflow-phase")
+	RegisterEventType[RankedStats]("/lol-ranked/v1/current-ranked-stats")
+	RegisterEventType[[]Friend]("/lol-chat/v1/friends")
+}
+
+// RegisterEventType registers T as the data type of events whose URI matches
+// pattern, an exact URI or a SubscribePattern pattern. Registering a pattern
+// again replaces its type.
+//
+//	lcu.RegisterEventType[Wallet]("/lol-inventory/v1/wallet/*")
+//
+// Returns an error if the pattern is invalid.
+func RegisterEventType[T any](pattern string) error {
+	return eventDataTypes.register(pattern, reflect.TypeOf((*T)(nil)).Elem())
+}
+
+func (r *typeRegistry) register(pattern string, t reflect.Type) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.types[pattern]; !ok {
+		if err := r.patterns.add(pattern); err != nil {
+			return err
+		}
+	}
+	r.types[pattern] = t
+	return nil
+}
+
+// lookup returns the type registered for uri. An exact URI wins over
+// patterns, and longer patterns over shorter ones.
+func (r *typeRegistry) lookup(uri string) (reflect.Type, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if t, ok := r.types[uri]; ok {
+		return t, true
+	}
+
+	best := ""
+	for _, pattern := range r.patterns.match(uri) {
+		if len(pattern) > len(best) {
+			best = pattern
+		}
+	}
+	t, ok := r.types[best]
+	return t, ok
+}
+
+// patternsFor returns the patterns registered for t, sorted
+func (r *typeRegistry) patternsFor(t reflect.Type) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var patterns []string
+	for pattern, registered := range r.types {
+		if registered == t {
+			patterns = append(patterns, pattern)
+		}
+	}
+	slices.Sort(patterns)
+	return patterns
+}
+
+// SubscribeTyped registers a handler for the URIs whose data type was
+// registered as T with RegisterEventType. The event data is decoded into a
+// T before the handler is called; events that fail to decode are logged
+// and skipped.
+//
+//	lcu.SubscribeTyped(client, func(event *lcu.TypedEvent[lcu.ChampSelectSession]) {
+//		fmt.Println(event.Data.Timer.Phase)
+//	}, lcu.EventTypeUpdate)
+//
+// Parameters:
+//   - c: The client to subscribe with
+//   - handler: The function that will be called with the decoded events
+//   - eventTypes: One or more event types to filter for (Create, Update, Delete)
+//
+// Returns an error if:
+//   - No URI is registered for T
+//   - No event types are specified or an invalid event type is provided
+//   - Failed to send subscription message via WebSocket
+func SubscribeTyped[T any](c *Client, handler func(*TypedEvent[T]), eventTypes ...EventType) (*Subscription, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	patterns := eventDataTypes.patternsFor(t)
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("no event URI registered for %s", t)
+	}
+	if len(patterns) == 1 {
+		return SubscribeTypedPattern(c, patterns[0], handler, eventTypes...)
+	}
+
+	group := &Subscription{client: c, endpoint: strings.Join(patterns, ", ")}
+	for _, pattern := range patterns {
+		sub, err := SubscribeTypedPattern(c, pattern, handler, eventTypes...)
+		if err != nil {
+			group.Unsubscribe()
+			return nil, err
+		}
+		group.group = append(group.group, sub)
+	}
+	return group, nil
+}
+
+// SubscribeTypedPattern is like SubscribeTyped but for the URIs matching
+// pattern, an exact URI or a SubscribePattern pattern, whatever type is registered for them
+func SubscribeTypedPattern[T any](c *Client, pattern string, handler func(*TypedEvent[T]), eventTypes ...EventType) (*Subscription, error) {
+	typed := func(event *Event) {
+		var data T
+		if event.EventType != string(EventTypeDelete) {
+			if err := event.Decode(&data); err != nil {
+				c.logger.Error("websocket", "Skipping event: %v", err)
+				return
+			}
+		}
+		handler(&TypedEvent[T]{
+			EventType: event.EventType,
+			URI:       event.URI,
+			Data:      data,
+			Event:     event,
+		})
+	}
+
+	if isPattern(pattern) {
+		return c.SubscribePattern(pattern, typed, eventTypes...)
+	}
+	return c.Subscribe(pattern, typed, eventTypes...)
+}
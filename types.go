@@ -9,6 +9,15 @@ const (
 	EventTypeDelete EventType = "Delete"
 )
 
+// ConnectionState represents the state of the WebSocket connection to the LCU
+type ConnectionState string
+
+const (
+	ConnectionStateConnected    ConnectionState = "Connected"
+	ConnectionStateDisconnected ConnectionState = "Disconnected"
+	ConnectionStateReconnecting ConnectionState = "Reconnecting"
+)
+
 // Common queue IDs for matchmaking
 const (
 	QueueCustom       = 0    // Custom Game
@@ -28,14 +37,20 @@ const (
 type GamePhase string
 
 const (
-	GamePhaseNone            GamePhase = "None"
-	GamePhaseLobby           GamePhase = "Lobby"
-	GamePhaseMatchmaking     GamePhase = "Matchmaking"
-	GamePhaseChampSelect     GamePhase = "ChampSelect"
-	GamePhaseInProgress      GamePhase = "InProgress"
-	GamePhaseWaitingForStats GamePhase = "WaitingForStats"
-	GamePhasePreEndOfGame    GamePhase = "PreEndOfGame"
-	GamePhaseEndOfGame       GamePhase = "EndOfGame"
+	GamePhaseNone                  GamePhase = "None"
+	GamePhaseLobby                 GamePhase = "Lobby"
+	GamePhaseMatchmaking           GamePhase = "Matchmaking"
+	GamePhaseCheckedIntoTournament GamePhase = "CheckedIntoTournament"
+	GamePhaseReadyCheck            GamePhase = "ReadyCheck"
+	GamePhaseChampSelect           GamePhase = "ChampSelect"
+	GamePhaseGameStart             GamePhase = "GameStart"
+	GamePhaseFailedToLaunch        GamePhase = "FailedToLaunch"
+	GamePhaseInProgress            GamePhase = "InProgress"
+	GamePhaseReconnect             GamePhase = "Reconnect"
+	GamePhaseWaitingForStats       GamePhase = "WaitingForStats"
+	GamePhasePreEndOfGame          GamePhase = "PreEndOfGame"
+	GamePhaseEndOfGame             GamePhase = "EndOfGame"
+	GamePhaseTerminatedInError     GamePhase = "TerminatedInError"
 )
 
 // Summoner represents a League of Legends summoner
@@ -332,14 +347,17 @@ type MatchmakingSearchState struct {
 		PenaltyTimeRemaining    float64 `json:"penaltyTimeRemaining"`
 		Reason                  string  `json:"reason"`
 	} `json:"lowPriorityData"`
-	ReadyCheck struct {
-		DeclinerIds    []int64 `json:"declinerIds"`
-		DodgeWarning   string  `json:"dodgeWarning"`
-		PlayerResponse string  `json:"playerResponse"`
-		State          string  `json:"state"`
-		SuppressUx     bool    `json:"suppressUx"`
-		Timer          float64 `json:"timer"`
-	} `json:"readyCheck"`
-	SearchState string  `json:"searchState"`
-	TimeInQueue float64 `json:"timeInQueue"`
+	ReadyCheck  ReadyCheck `json:"readyCheck"`
+	SearchState string     `json:"searchState"`
+	TimeInQueue float64    `json:"timeInQueue"`
+}
+
+// ReadyCheck represents the ready check shown when a match is found
+type ReadyCheck struct {
+	DeclinerIds    []int64 `json:"declinerIds"`
+	DodgeWarning   string  `json:"dodgeWarning"`
+	PlayerResponse string  `json:"playerResponse"` // None, Accepted or Declined
+	State          string  `json:"state"`          // Invalid, InProgress, EveryoneReady, StrangerNotReady or PartyNotReady
+	SuppressUx     bool    `json:"suppressUx"`
+	Timer          float64 `json:"timer"` // Seconds since the ready check started
 }